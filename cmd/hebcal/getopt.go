@@ -0,0 +1,121 @@
+package main
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"strings"
+)
+
+// option describes a single command-line switch. The classic hebcal
+// switches are case-sensitive single letters that may be bundled ("-sc"),
+// which the standard flag package does not support, so they are parsed
+// here in the style of POSIX getopt with GNU long options.
+type option struct {
+	short  byte   // single-letter form, or 0 if there is none
+	long   string // long form without the leading "--", or ""
+	hasArg bool   // whether the option takes an argument
+	set    func(arg string) error
+}
+
+// getopt parses args (not including the program name) according to
+// options, calling each matched option's set function, and returns the
+// remaining non-option arguments. Parsing stops at "--" or at the first
+// argument that is not an option.
+func getopt(args []string, options []option) ([]string, error) {
+	byShort := make(map[byte]*option)
+	byLong := make(map[string]*option)
+	for i := range options {
+		opt := &options[i]
+		if opt.short != 0 {
+			byShort[opt.short] = opt
+		}
+		if opt.long != "" {
+			byLong[opt.long] = opt
+		}
+	}
+	for len(args) != 0 {
+		arg := args[0]
+		if arg == "--" {
+			return args[1:], nil
+		}
+		if len(arg) < 2 || arg[0] != '-' || isNumber(arg) {
+			return args, nil
+		}
+		args = args[1:]
+		if strings.HasPrefix(arg, "--") {
+			name, value, hasValue := strings.Cut(arg[2:], "=")
+			opt, ok := byLong[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown option --%s", name)
+			}
+			if opt.hasArg && !hasValue {
+				if len(args) == 0 {
+					return nil, fmt.Errorf("option --%s requires an argument", name)
+				}
+				value, args = args[0], args[1:]
+			} else if !opt.hasArg && hasValue {
+				return nil, fmt.Errorf("option --%s does not take an argument", name)
+			}
+			if err := opt.set(value); err != nil {
+				return nil, fmt.Errorf("option --%s: %w", name, err)
+			}
+			continue
+		}
+		for i := 1; i < len(arg); i++ {
+			c := arg[i]
+			opt, ok := byShort[c]
+			if !ok {
+				return nil, fmt.Errorf("unknown option -%c", c)
+			}
+			if !opt.hasArg {
+				if err := opt.set(""); err != nil {
+					return nil, fmt.Errorf("option -%c: %w", c, err)
+				}
+				continue
+			}
+			// The argument is either the rest of this word ("-b18")
+			// or the next word ("-b 18").
+			value := arg[i+1:]
+			if value == "" {
+				if len(args) == 0 {
+					return nil, fmt.Errorf("option -%c requires an argument", c)
+				}
+				value, args = args[0], args[1:]
+			}
+			if err := opt.set(value); err != nil {
+				return nil, fmt.Errorf("option -%c: %w", c, err)
+			}
+			break
+		}
+	}
+	return args, nil
+}
+
+// isNumber reports whether s looks like a negative number, so that
+// a year such as "-200" is not mistaken for a bundle of options.
+func isNumber(s string) bool {
+	if len(s) < 2 || s[0] != '-' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,444 @@
+// Command hebcal prints a list of Jewish holidays and other Hebrew calendar
+// events for a given Gregorian or Hebrew date range, one event per line,
+// in the format of the classic hebcal program:
+//
+//	$ hebcal -c -C Chicago 9 2022
+//	9/2/2022 Candle lighting: 7:04
+//	9/3/2022 Havdalah: 8:03
+//	...
+//
+// Run "hebcal --help" for the list of options.
+package main
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+const usage = `usage: hebcal [options] [[ month [ day ]] year ]
+       hebcal cities
+
+OPTIONS:
+  -a                 Use Ashkenazi Hebrew transliterations
+  -b mins            Light candles this many minutes before sundown (default 18)
+  -c                 Print candle-lighting and Havdalah times
+  -C city            Set location for candle-lighting times to city
+  -d                 Print the Hebrew date for the entire date range
+  -D                 Print the Hebrew date for dates with some event
+  -e                 Output 'European' dates -- DD.MM.YYYY
+  -E                 Output 24-hour times (e.g. 18:37 instead of 6:37)
+  -F                 Output the Daf Yomi for the entire date range
+  -g                 Output ISO 8601 dates -- YYYY-MM-DD (overrides -y)
+  -h                 Suppress default holidays
+  -H                 Interpret year (and month) as Hebrew
+  -i                 Use Israeli holiday and sedra schedule
+  -I file            Get non-yahrzeit Hebrew user events from file (or .csv)
+  -l xx,yy           Latitude in degrees and minutes (negative is south)
+  -L xx,yy           Longitude in degrees and minutes (negative is west)
+  -m mins            Havdalah this many minutes after sundown
+  -M                 Print the molad on Shabbat Mevarchim
+  -o                 Add days of the Omer
+  -O                 Output sunrise and sunset times every day
+  -r                 Tab-delimited output
+  -s                 Add weekly sedrot on Saturdays
+  -S                 Print the sedra of the week on all calendar days
+  -t                 Only output for today's date
+  -T                 Like -t, but without the Gregorian date
+  -w                 Add day of the week
+  -W                 Weekly view: show daily events once a week
+  -x                 Suppress Rosh Chodesh
+  -y                 Print only the last two digits of the year
+  -Y file            Get yahrzeit dates from file (or .csv)
+  -z tzid            Use time zone tzid (e.g. America/New_York)
+  -Z                 Add daily zmanim
+      --lang locale  Render events in locale (e.g. he, fr, ashkenazi)
+      --polar name   Approximate times when the sun does not set (nearest-latitude,
+                     nearest-day, fixed-minutes or jerusalem)
+      --years N      Generate events for N years (default 1)
+      --zmanim name  Add daily zmanim of profile name (e.g. chabad, yeshivish)
+      --help         Print this message
+`
+
+func main() {
+	w := bufio.NewWriter(os.Stdout)
+	err := run(os.Args[1:], w, time.Now())
+	w.Flush()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "hebcal: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// cli holds the parsed command line.
+type cli struct {
+	calOpts      hebcal.CalOptions
+	locale       string
+	euroDates    bool
+	isoDates     bool
+	twoDigitYear bool
+	tabs         bool
+	weekday      bool
+	today        bool
+	noGregDate   bool
+	help         bool
+	cityName     string
+	tzid         string
+	latitude     *float64
+	longitude    *float64
+	yahrzeitFile string
+	inputFile    string
+}
+
+func (c *cli) options() []option {
+	opts := &c.calOpts
+	flag := func(p *bool) func(string) error {
+		return func(string) error { *p = true; return nil }
+	}
+	intArg := func(p *int) func(string) error {
+		return func(arg string) error {
+			n, err := strconv.Atoi(arg)
+			if err != nil {
+				return fmt.Errorf("invalid number %q", arg)
+			}
+			*p = n
+			return nil
+		}
+	}
+	strArg := func(p *string) func(string) error {
+		return func(arg string) error { *p = arg; return nil }
+	}
+	return []option{
+		{short: 'a', set: func(string) error { c.locale = "ashkenazi"; return nil }},
+		{short: 'b', hasArg: true, set: intArg(&opts.CandleLightingMins)},
+		{short: 'c', set: flag(&opts.CandleLighting)},
+		{short: 'C', hasArg: true, set: strArg(&c.cityName)},
+		{short: 'd', set: flag(&opts.AddHebrewDates)},
+		{short: 'D', set: flag(&opts.AddHebrewDatesForEvents)},
+		{short: 'e', set: flag(&c.euroDates)},
+		{short: 'E', set: flag(&opts.Hour24)},
+		{short: 'F', set: flag(&opts.DafYomi)},
+		{short: 'g', set: flag(&c.isoDates)},
+		{short: 'h', set: flag(&opts.NoHolidays)},
+		{short: 'H', set: flag(&opts.IsHebrewYear)},
+		{short: 'i', set: flag(&opts.IL)},
+		{short: 'I', hasArg: true, set: strArg(&c.inputFile)},
+		{short: 'l', hasArg: true, set: coordArg(&c.latitude, 90)},
+		{short: 'L', hasArg: true, set: coordArg(&c.longitude, 180)},
+		{short: 'm', hasArg: true, set: intArg(&opts.HavdalahMins)},
+		{short: 'M', set: flag(&opts.Molad)},
+		{short: 'o', set: flag(&opts.Omer)},
+		{short: 'O', set: flag(&opts.SunriseSunset)},
+		{short: 'r', set: flag(&c.tabs)},
+		{short: 's', set: flag(&opts.Sedrot)},
+		{short: 'S', set: flag(&opts.DailySedra)},
+		{short: 't', set: flag(&c.today)},
+		{short: 'T', set: func(string) error { c.today = true; c.noGregDate = true; return nil }},
+		{short: 'w', set: flag(&c.weekday)},
+		{short: 'W', set: flag(&opts.WeeklyAbbreviated)},
+		{short: 'x', set: flag(&opts.NoRoshChodesh)},
+		{short: 'y', set: flag(&c.twoDigitYear)},
+		{short: 'Y', hasArg: true, set: strArg(&c.yahrzeitFile)},
+		{short: 'z', hasArg: true, set: strArg(&c.tzid)},
+		{short: 'Z', set: flag(&opts.DailyZmanim)},
+		{long: "lang", hasArg: true, set: strArg(&c.locale)},
+		{long: "polar", hasArg: true, set: func(arg string) error {
+			f, err := zmanim.ParsePolarFallback(arg)
+			opts.PolarFallback = f
+			return err
+		}},
+		{long: "years", hasArg: true, set: intArg(&opts.NumYears)},
+		{long: "zmanim", hasArg: true, set: func(arg string) error {
+			opts.DailyZmanim = true
+			opts.ZmanimProfile = arg
+			return nil
+		}},
+		{long: "help", set: flag(&c.help)},
+	}
+}
+
+// coordArg parses a coordinate given either as "degrees,minutes" (the
+// classic hebcal format, e.g. "41,52") or as decimal degrees ("41.87").
+func coordArg(p **float64, limit float64) func(string) error {
+	return func(arg string) error {
+		var deg float64
+		if degStr, minStr, ok := strings.Cut(arg, ","); ok {
+			d, err1 := strconv.Atoi(degStr)
+			m, err2 := strconv.Atoi(minStr)
+			if err1 != nil || err2 != nil || m < 0 || m >= 60 {
+				return fmt.Errorf("invalid coordinate %q", arg)
+			}
+			deg = math.Abs(float64(d)) + float64(m)/60.0
+			if strings.HasPrefix(degStr, "-") {
+				deg = -deg
+			}
+		} else {
+			d, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return fmt.Errorf("invalid coordinate %q", arg)
+			}
+			deg = d
+		}
+		if math.Abs(deg) > limit {
+			return fmt.Errorf("coordinate %q out of range", arg)
+		}
+		*p = &deg
+		return nil
+	}
+}
+
+// run is the body of main, separated out for testing.
+func run(args []string, w io.Writer, now time.Time) error {
+	if len(args) != 0 && args[0] == "cities" {
+		for _, loc := range zmanim.AllCities() {
+			fmt.Fprintln(w, loc.Name)
+		}
+		return nil
+	}
+	c := &cli{locale: "en"}
+	rest, err := getopt(args, c.options())
+	if err != nil {
+		return err
+	}
+	if c.help {
+		_, err := io.WriteString(w, usage)
+		return err
+	}
+	if err := c.setRange(rest, now); err != nil {
+		return err
+	}
+	if err := c.setLocation(); err != nil {
+		return err
+	}
+	if err := c.readFiles(); err != nil {
+		return err
+	}
+	cal, err := hebcal.NewCalendar(&c.calOpts)
+	if err != nil {
+		return err
+	}
+	for {
+		ev, ok := cal.Next()
+		if !ok {
+			return nil
+		}
+		if _, err := io.WriteString(w, c.formatEvent(ev)+"\n"); err != nil {
+			return err
+		}
+	}
+}
+
+// setRange interprets the positional arguments "[[ month [ day ]] year ]".
+func (c *cli) setRange(args []string, now time.Time) error {
+	opts := &c.calOpts
+	if c.today {
+		if len(args) != 0 {
+			return errors.New("-t and -T do not take a date")
+		}
+		hd := hdate.FromTime(now)
+		opts.Start, opts.End = hd, hd
+		opts.AddHebrewDates = true
+		opts.Omer = true
+		return nil
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	if len(args) > 3 {
+		return errors.New("too many arguments; expected [[ month [ day ]] year ]")
+	}
+	year, err := strconv.Atoi(args[len(args)-1])
+	if err != nil || year == 0 {
+		return fmt.Errorf("invalid year %q", args[len(args)-1])
+	}
+	if len(args) == 1 {
+		opts.Year = year
+		return nil
+	}
+	if opts.IsHebrewYear {
+		month, err := hdate.MonthFromName(args[0])
+		if err != nil {
+			return err
+		}
+		if len(args) == 3 {
+			day, err := strconv.Atoi(args[1])
+			if err != nil || day < 1 || day > hdate.DaysInMonth(month, year) {
+				return fmt.Errorf("invalid day %q", args[1])
+			}
+			opts.Start = hdate.New(year, month, day)
+			opts.End = opts.Start
+			return nil
+		}
+		opts.Start = hdate.New(year, month, 1)
+		opts.End = hdate.New(year, month, hdate.DaysInMonth(month, year))
+		return nil
+	}
+	month, err := strconv.Atoi(args[0])
+	if err != nil || month < 1 || month > 12 {
+		return fmt.Errorf("invalid month %q", args[0])
+	}
+	if len(args) == 3 {
+		day, err := strconv.Atoi(args[1])
+		t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if err != nil || t.Day() != day {
+			return fmt.Errorf("invalid day %q", args[1])
+		}
+		opts.Start = hdate.FromGregorian(year, time.Month(month), day)
+		opts.End = opts.Start
+		return nil
+	}
+	opts.Year = year
+	opts.Month = time.Month(month)
+	return nil
+}
+
+// setLocation resolves -C, -l, -L and -z into opts.Location. Options that
+// need a location default to New York, as the classic program does.
+func (c *cli) setLocation() error {
+	opts := &c.calOpts
+	var loc *zmanim.Location
+	switch {
+	case c.latitude != nil || c.longitude != nil:
+		if c.latitude == nil || c.longitude == nil {
+			return errors.New("-l and -L must be specified together")
+		}
+		if c.tzid == "" {
+			return errors.New("-l and -L require a time zone (-z)")
+		}
+		l := zmanim.NewLocation(formatLatLong(*c.latitude, *c.longitude), "",
+			*c.latitude, *c.longitude, 0, c.tzid)
+		loc = &l
+	case c.cityName != "":
+		loc = zmanim.LookupCity(c.cityName)
+		if loc == nil {
+			return fmt.Errorf("unknown city %q; run \"hebcal cities\" for a list", c.cityName)
+		}
+	case opts.CandleLighting || opts.SunriseSunset || opts.DailyZmanim:
+		loc = zmanim.LookupCity("New York")
+	}
+	if loc != nil && c.tzid != "" {
+		loc.TimeZoneId = c.tzid
+	}
+	if loc != nil {
+		if _, err := zmanim.LoadLocation(loc.TimeZoneId); err != nil {
+			return err
+		}
+	}
+	opts.Location = loc
+	return nil
+}
+
+// formatLatLong names a user-specified location, e.g. "41°52'N, 87°38'W".
+func formatLatLong(lat, long float64) string {
+	part := func(deg float64, pos, neg string) string {
+		dir := pos
+		if deg < 0 {
+			dir = neg
+		}
+		minutes := int(math.Round(math.Abs(deg) * 60))
+		return fmt.Sprintf("%d°%02d'%s", minutes/60, minutes%60, dir)
+	}
+	return part(lat, "N", "S") + ", " + part(long, "E", "W")
+}
+
+func (c *cli) readFiles() error {
+	if c.yahrzeitFile != "" {
+		f, err := os.Open(c.yahrzeitFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		read := hebcal.ReadYahrzeits
+		if isCSV(c.yahrzeitFile) {
+			read = hebcal.ReadYahrzeitsCSV
+		}
+		yahrzeits, err := read(f)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.yahrzeitFile, err)
+		}
+		c.calOpts.Yahrzeits = yahrzeits
+	}
+	if c.inputFile != "" {
+		f, err := os.Open(c.inputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		read := hebcal.ReadUserEvents
+		if isCSV(c.inputFile) {
+			read = hebcal.ReadUserEventsCSV
+		}
+		userEvents, err := read(f)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.inputFile, err)
+		}
+		c.calOpts.UserEvents = userEvents
+	}
+	return nil
+}
+
+// isCSV reports whether filename names a CSV file rather than a file in
+// the classic hebcal format.
+func isCSV(filename string) bool {
+	return strings.EqualFold(filepath.Ext(filename), ".csv")
+}
+
+// formatEvent returns an output line such as "9/25/2022 Erev Rosh Hashana".
+func (c *cli) formatEvent(ev event.CalEvent) string {
+	desc := ev.Render(c.locale)
+	if c.noGregDate {
+		return desc
+	}
+	year, month, day := ev.GetDate().Greg()
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	var layout string
+	switch {
+	case c.isoDates:
+		layout = "2006-01-02"
+	case c.euroDates && c.twoDigitYear:
+		layout = "2.1.06"
+	case c.euroDates:
+		layout = "2.1.2006"
+	case c.twoDigitYear:
+		layout = "1/2/06"
+	default:
+		layout = "1/2/2006"
+	}
+	date := t.Format(layout)
+	if c.weekday {
+		date = t.Format("Mon") + " " + date
+	}
+	sep := " "
+	if c.tabs {
+		sep = "\t"
+	}
+	return date + sep + desc
+}
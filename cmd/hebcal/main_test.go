@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2022, time.September, 26, 12, 0, 0, 0, time.UTC)
+
+func runLines(t *testing.T, args ...string) []string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := run(args, &buf, now)
+	assert.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+}
+
+func TestRunCandleLighting(t *testing.T) {
+	lines := runLines(t, "-c", "-C", "Chicago", "9", "2022")
+	assert.Equal(t, []string{
+		"9/2/2022 Candle lighting: 7:04",
+		"9/3/2022 Havdalah: 8:03",
+	}, lines[:2])
+}
+
+func TestRunBundledFlags(t *testing.T) {
+	lines := runLines(t, "-scwe", "-CChicago", "10", "1", "2022")
+	assert.Equal(t, []string{
+		"Sat 1.10.2022 Parashat Vayeilech",
+		"Sat 1.10.2022 Shabbat Shuva",
+		"Sat 1.10.2022 Havdalah: 7:13",
+	}, lines)
+}
+
+func TestRunHebrewDate(t *testing.T) {
+	lines := runLines(t, "-H", "-d", "-g", "Tishrei", "1", "5783")
+	assert.Equal(t, []string{
+		"2022-09-26 1st of Tishrei, 5783",
+		"2022-09-26 Rosh Hashana 5783",
+	}, lines)
+	lines = runLines(t, "-T", "--lang=he")
+	assert.Equal(t, "א׳ תִשְׁרֵי תשפ״ג", lines[0])
+}
+
+func TestRunLatLong(t *testing.T) {
+	lines := runLines(t, "-l", "41,52", "-L", "-87,38", "-z", "America/Chicago", "-O", "-r", "1", "1", "2023")
+	assert.Equal(t, []string{"1/1/2023\tSunrise: 7:18; Sunset 4:30"}, lines)
+}
+
+func TestRunErrors(t *testing.T) {
+	var buf bytes.Buffer
+	assert.EqualError(t, run([]string{"-X"}, &buf, now), "unknown option -X")
+	assert.EqualError(t, run([]string{"-b"}, &buf, now), "option -b requires an argument")
+	assert.EqualError(t, run([]string{"-C", "Atlantis", "-c"}, &buf, now),
+		`unknown city "Atlantis"; run "hebcal cities" for a list`)
+	assert.EqualError(t, run([]string{"-l", "41,52", "-c"}, &buf, now), "-l and -L must be specified together")
+	assert.EqualError(t, run([]string{"13", "2022"}, &buf, now), `invalid month "13"`)
+}
+
+func TestGetopt(t *testing.T) {
+	var a, b bool
+	var s string
+	opts := []option{
+		{short: 'a', set: func(string) error { a = true; return nil }},
+		{short: 'b', long: "bee", set: func(string) error { b = true; return nil }},
+		{short: 's', long: "str", hasArg: true, set: func(arg string) error { s = arg; return nil }},
+	}
+	rest, err := getopt([]string{"-ab", "--str=x", "-200"}, opts)
+	assert.NoError(t, err)
+	assert.True(t, a)
+	assert.True(t, b)
+	assert.Equal(t, "x", s)
+	assert.Equal(t, []string{"-200"}, rest)
+	rest, err = getopt([]string{"-sfoo", "--", "-a"}, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", s)
+	assert.Equal(t, []string{"-a"}, rest)
+	_, err = getopt([]string{"--bee=1"}, opts)
+	assert.EqualError(t, err, "option --bee does not take an argument")
+}
+
+func TestRunZmanimProfile(t *testing.T) {
+	lines := runLines(t, "-h", "--zmanim", "chabad", "-C", "Jerusalem", "4", "26", "2024")
+	assert.Equal(t, "4/26/2024 Alot HaShachar (Baal HaTanya): 4:36", lines[0])
+	assert.Equal(t, "4/26/2024 Tzeit HaKochavim (Baal HaTanya): 7:41", lines[len(lines)-1])
+	var buf bytes.Buffer
+	assert.EqualError(t, run([]string{"--zmanim", "nusach-mars", "-C", "Jerusalem"}, &buf, now),
+		`unknown zmanim profile "nusach-mars"`)
+}
+
+func TestRunPolar(t *testing.T) {
+	lines := runLines(t, "-h", "-c", "-l", "69,39", "-L", "18,57", "-z", "Europe/Oslo",
+		"--polar=fixed-minutes", "6", "21", "2024")
+	assert.Equal(t, []string{"6/21/2024 Candle lighting: 6:26"}, lines)
+	var buf bytes.Buffer
+	assert.EqualError(t, run([]string{"--polar=tundra"}, &buf, now), `option --polar: unknown polar fallback "tundra"`)
+}
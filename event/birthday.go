@@ -0,0 +1,38 @@
+package event
+
+import (
+	"github.com/hebcal/hdate"
+)
+
+// BirthdayEvent is a Hebrew birthday, bar mitzvah or bat mitzvah
+type BirthdayEvent struct {
+	Date hdate.HDate // Date of occurrence
+	Desc string      // Description, e.g. "Bar Mitzvah: Moshe"
+	Age  int         // Age in Hebrew years reached on Date
+}
+
+func (ev BirthdayEvent) GetDate() hdate.HDate {
+	return ev.Date
+}
+
+func (ev BirthdayEvent) Render(locale string) string {
+	return ev.Desc
+}
+
+func (ev BirthdayEvent) GetFlags() HolidayFlags {
+	return USER_EVENT
+}
+
+func (ev BirthdayEvent) GetEmoji() string {
+	return ""
+}
+
+func (ev BirthdayEvent) Basename() string {
+	return ev.Desc
+}
+
+// GetCategories returns ["user", "birthday"], distinguishing birthdays
+// from yahrzeits and other user events.
+func (ev BirthdayEvent) GetCategories() []string {
+	return []string{"user", "birthday"}
+}
@@ -80,6 +80,10 @@ const (
 	// Daily learning schedule supplied by a plugin (e.g. 929, Daily Rambam)
 	// via the dailylearning registry, with no dedicated flag of its own.
 	DAILY_LEARNING
+	// Earliest and latest times for Kiddush Levana (sanctification of the moon)
+	KIDDUSH_LEVANA
+	// Tekufot, the four seasons of the year (Tekufat Nisan, Tammuz, ...)
+	TEKUFA
 )
 
 type CalEvent interface {
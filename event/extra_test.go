@@ -238,3 +238,16 @@ func TestParshaEvent_Extra(t *testing.T) {
 	assert.Equal(t, []string{"parashat"}, ev.GetCategories())
 }
 
+func TestBirthdayEvent(t *testing.T) {
+	hd := hdate.New(5783, hdate.Nisan, 1)
+	ev := event.BirthdayEvent{
+		Date: hd,
+		Desc: "Bar Mitzvah: Moshe",
+		Age:  13,
+	}
+
+	assert.Equal(t, hd, ev.GetDate())
+	assert.Equal(t, event.USER_EVENT, ev.GetFlags())
+	assert.Equal(t, "Bar Mitzvah: Moshe", ev.Render("en"))
+	assert.Equal(t, []string{"user", "birthday"}, ev.GetCategories())
+}
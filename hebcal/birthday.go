@@ -0,0 +1,96 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+)
+
+const (
+	barMitzvahAge = 13
+	batMitzvahAge = 12
+)
+
+// BirthHDate returns the Hebrew date of birth of someone born on the
+// Gregorian date of t. Since the Hebrew day begins at sunset, someone
+// born after sunset was born on the following Hebrew date.
+func BirthHDate(t time.Time, afterSunset bool) hdate.HDate {
+	hd := hdate.FromTime(t)
+	if afterSunset {
+		hd = hd.Next()
+	}
+	return hd
+}
+
+// GetBirthday returns the Hebrew birthday in Hebrew year hyear of someone
+// born on the Hebrew date birth. It returns an error if hyear precedes the
+// year of birth.
+//
+// Birthdays differ from yahrzeits (see hdate.GetYahrzeit): someone born in
+// Adar of an ordinary year, or Adar II of a leap year, has a birthday in
+// Adar II of a leap year, and someone born on 30 Cheshvan, 30 Kislev or 30
+// Adar I has a birthday on the first of the following month in years
+// without that day.
+func GetBirthday(hyear int, birth hdate.HDate) (hdate.HDate, error) {
+	return hdate.GetBirthdayOrAnniversary(hyear, birth)
+}
+
+// GetBarMitzvah returns the date of the bar mitzvah of a boy born on the
+// Hebrew date birth: his 13th Hebrew birthday.
+func GetBarMitzvah(birth hdate.HDate) hdate.HDate {
+	hd, _ := GetBirthday(birth.Year()+barMitzvahAge, birth)
+	return hd
+}
+
+// GetBatMitzvah returns the date of the bat mitzvah of a girl born on the
+// Hebrew date birth: her Hebrew birthday at age, or at 12 if age is 0.
+func GetBatMitzvah(birth hdate.HDate, age int) hdate.HDate {
+	if age <= 0 {
+		age = batMitzvahAge
+	}
+	hd, _ := GetBirthday(birth.Year()+age, birth)
+	return hd
+}
+
+// makeBirthdayEvent returns the event for the Hebrew birthday of b in
+// Hebrew year hyear, or false if b was not yet born.
+func makeBirthdayEvent(hyear int, b UserBirthday, opts *CalOptions) (event.BirthdayEvent, bool) {
+	birth := BirthHDate(b.Date, b.AfterSunset)
+	age := hyear - birth.Year()
+	if age < 1 {
+		return event.BirthdayEvent{}, false
+	}
+	hd, err := GetBirthday(hyear, birth)
+	if err != nil {
+		return event.BirthdayEvent{}, false
+	}
+	batAge := opts.BatMitzvahAge
+	if batAge <= 0 {
+		batAge = batMitzvahAge
+	}
+	desc := "Hebrew Birthday: " + b.Name
+	switch {
+	case b.Mitzvah == BarMitzvah && age == barMitzvahAge:
+		desc = "Bar Mitzvah: " + b.Name
+	case b.Mitzvah == BatMitzvah && age == batAge:
+		desc = "Bat Mitzvah: " + b.Name
+	}
+	return event.BirthdayEvent{Date: hd, Desc: desc, Age: age}, true
+}
@@ -0,0 +1,79 @@
+package hebcal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/hebcal"
+)
+
+func TestGetBirthday(t *testing.T) {
+	assert := assert.New(t)
+	// born in Adar of an ordinary year: Adar II in a leap year
+	birth := hdate.New(5783, hdate.Adar1, 15)
+	hd, err := hebcal.GetBirthday(5784, birth)
+	assert.NoError(err)
+	assert.Equal(hdate.New(5784, hdate.Adar2, 15), hd)
+	// born in Adar I: Adar in an ordinary year, Adar I in a leap year
+	birth = hdate.New(5784, hdate.Adar1, 10)
+	hd, _ = hebcal.GetBirthday(5785, birth)
+	assert.Equal(hdate.New(5785, hdate.Adar1, 10), hd)
+	hd, _ = hebcal.GetBirthday(5787, birth)
+	assert.Equal(hdate.New(5787, hdate.Adar1, 10), hd)
+	// 30 Cheshvan in a year with only 29 days in Cheshvan
+	hd, _ = hebcal.GetBirthday(5784, hdate.New(5783, hdate.Cheshvan, 30))
+	assert.Equal(hdate.New(5784, hdate.Kislev, 1), hd)
+	_, err = hebcal.GetBirthday(5782, birth)
+	assert.Error(err)
+}
+
+func TestBirthHDate(t *testing.T) {
+	assert := assert.New(t)
+	day := time.Date(2010, time.March, 15, 0, 0, 0, 0, time.UTC)
+	assert.Equal(hdate.New(5770, hdate.Adar1, 29), hebcal.BirthHDate(day, false))
+	assert.Equal(hdate.New(5770, hdate.Nisan, 1), hebcal.BirthHDate(day, true))
+}
+
+func TestGetBarBatMitzvah(t *testing.T) {
+	assert := assert.New(t)
+	birth := hdate.New(5770, hdate.Nisan, 1)
+	assert.Equal(hdate.New(5783, hdate.Nisan, 1), hebcal.GetBarMitzvah(birth))
+	assert.Equal(hdate.New(5782, hdate.Nisan, 1), hebcal.GetBatMitzvah(birth, 0))
+	assert.Equal(hdate.New(5783, hdate.Nisan, 1), hebcal.GetBatMitzvah(birth, 13))
+	// born in Adar, bar mitzvah in Adar II of a leap year
+	assert.Equal(hdate.New(5784, hdate.Adar2, 7), hebcal.GetBarMitzvah(hdate.New(5771, hdate.Adar2, 7)))
+	assert.Equal(hdate.New(5785, hdate.Adar1, 7), hebcal.GetBarMitzvah(hdate.New(5772, hdate.Adar1, 7)))
+}
+
+func TestHebrewCalendarBirthdays(t *testing.T) {
+	day := time.Date(2010, time.March, 15, 0, 0, 0, 0, time.UTC)
+	opts := &hebcal.CalOptions{
+		Start:      hdate.New(5783, hdate.Nisan, 1),
+		End:        hdate.New(5783, hdate.Nisan, 1),
+		NoHolidays: true,
+		Birthdays: []hebcal.UserBirthday{
+			{Date: day, AfterSunset: true, Name: "Moshe", Mitzvah: hebcal.BarMitzvah},
+			{Date: day, AfterSunset: true, Name: "Rivka", Mitzvah: hebcal.BatMitzvah},
+			{Date: day, Name: "Before Sunset"},
+		},
+		BatMitzvahAge: 13,
+	}
+	checkEvents(t, "en", opts, []string{
+		"2023-03-23 Bar Mitzvah: Moshe",
+		"2023-03-23 Bat Mitzvah: Rivka",
+	})
+
+	events, err := hebcal.HebrewCalendar(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user", "birthday"}, events[0].GetCategories())
+
+	// by default, bat mitzvah is at 12
+	opts.BatMitzvahAge = 0
+	checkEvents(t, "en", opts, []string{
+		"2023-03-23 Bar Mitzvah: Moshe",
+		"2023-03-23 Hebrew Birthday: Rivka",
+	})
+}
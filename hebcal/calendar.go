@@ -0,0 +1,327 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+// Derived from original C version, Copyright (C) 1994-2004 Danny Sadinoff
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/dailylearning"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/molad"
+	"github.com/hebcal/hebcal-go/omer"
+	"github.com/hebcal/hebcal-go/sedra"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+// Calendar generates the events of HebrewCalendar lazily, one day at a
+// time, so that long ranges need not be held in memory and callers can
+// stop as soon as they have found what they need.
+//
+// A Calendar is not safe for concurrent use.
+type Calendar struct {
+	opts               *CalOptions
+	abs                int64 // next day to generate
+	endAbs             int64
+	firstWeekday       time.Weekday
+	yerushalmiCalendar string
+
+	// per-year state, recomputed when the Hebrew year changes
+	currentYear  int
+	holidaysYear []event.HolidayEvent
+	sedraYear    sedra.Sedra
+	beginOmer    int64
+	endOmer      int64
+	userEvents   []event.CalEvent
+	levanaYear   []TimedEvent
+	tekufotYear  []TimedEvent
+
+	// events of the most recently generated day not yet returned by Next
+	pending []event.CalEvent
+	pos     int
+}
+
+// NewCalendar validates opts and returns a Calendar that generates the
+// same events, in the same order, as HebrewCalendar(opts). Like
+// HebrewCalendar, it fills in defaults in opts, which must not be modified
+// while the Calendar is in use.
+func NewCalendar(opts *CalOptions) (*Calendar, error) {
+	err := checkCandleOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.SunriseSunset && opts.Location == nil {
+		return nil, errors.New("opts.SunriseSunset requires opts.Location")
+	}
+	if opts.DailyZmanim && opts.Location == nil {
+		return nil, errors.New("opts.DailyZmanim requires opts.Location")
+	}
+	if opts.ZmanimProfile != "" {
+		if _, ok := zmanim.LookupProfile(opts.ZmanimProfile); !ok {
+			return nil, fmt.Errorf("unknown zmanim profile %q", opts.ZmanimProfile)
+		}
+	}
+	startAbs, endAbs, err := getStartAndEnd(opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Location != nil && opts.Location.CountryCode == "IL" {
+		opts.IL = true
+	}
+	opts.Mask = getMaskFromOptions(opts)
+	if opts.YerushalmiYomi && opts.YerushalmiEdition == 0 {
+		opts.YerushalmiEdition = Vilna
+	}
+	yerushalmiCalendar := "yerushalmi-vilna"
+	if opts.YerushalmiEdition == Schottenstein {
+		yerushalmiCalendar = "yerushalmi-schottenstein"
+	}
+	return &Calendar{
+		opts:               opts,
+		abs:                startAbs,
+		endAbs:             endAbs,
+		firstWeekday:       time.Weekday(startAbs % 7),
+		yerushalmiCalendar: yerushalmiCalendar,
+		currentYear:        -1,
+		pending:            make([]event.CalEvent, 0, 20),
+	}, nil
+}
+
+// Next returns the next event, or false when the range is exhausted.
+func (c *Calendar) Next() (event.CalEvent, bool) {
+	for c.pos >= len(c.pending) {
+		if c.abs > c.endAbs {
+			return nil, false
+		}
+		c.pending = c.generateDay(c.abs, c.pending[:0])
+		c.pos = 0
+		c.abs++
+	}
+	ev := c.pending[c.pos]
+	c.pending[c.pos] = nil
+	c.pos++
+	return ev, true
+}
+
+// All returns a function that calls yield for each remaining event,
+// stopping early if yield returns false. Its signature matches iter.Seq,
+// so with Go 1.23 or later it can be used in a range statement:
+//
+//	for ev := range cal.All() { ... }
+func (c *Calendar) All() func(yield func(event.CalEvent) bool) {
+	return func(yield func(event.CalEvent) bool) {
+		for {
+			ev, ok := c.Next()
+			if !ok || !yield(ev) {
+				return
+			}
+		}
+	}
+}
+
+// Walk calls fn for each remaining event. It stops and returns the error
+// if fn returns a non-nil error, or returns ctx.Err() if ctx is canceled.
+// The context is checked once per generated day.
+func (c *Calendar) Walk(ctx context.Context, fn func(event.CalEvent) error) error {
+	for {
+		if c.pos >= len(c.pending) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		ev, ok := c.Next()
+		if !ok {
+			return nil
+		}
+		if err := fn(ev); err != nil {
+			return err
+		}
+	}
+}
+
+// startYear recomputes the per-year state for Hebrew year hyear.
+func (c *Calendar) startYear(hyear int) {
+	opts := c.opts
+	il := opts.IL
+	c.currentYear = hyear
+	c.holidaysYear = GetHolidaysForYear(hyear, il)
+	if opts.Sedrot || opts.DailySedra {
+		c.sedraYear = sedra.New(hyear, il)
+	}
+	if opts.Omer {
+		c.beginOmer = hdate.ToRD(hyear, hdate.Nisan, 16)
+		c.endOmer = hdate.ToRD(hyear, hdate.Sivan, 5)
+	}
+	if opts.KiddushLevana {
+		c.levanaYear = makeKiddushLevanaEvents(hyear, opts)
+	}
+	if opts.Tekufot {
+		c.tekufotYear = makeTekufotEvents(hyear, opts)
+	}
+	numUserEvents := len(opts.Yahrzeits) + len(opts.UserEvents) + len(opts.Birthdays)
+	if numUserEvents != 0 {
+		userEvents := make([]event.CalEvent, 0, numUserEvents)
+		for _, yahrzeit := range opts.Yahrzeits {
+			for _, observedDate := range yahrzeit.Yahrzeits(hyear) {
+				userEvents = append(userEvents, event.UserEvent{
+					Date: observedDate,
+					Desc: yahrzeit.Name,
+				})
+			}
+		}
+		for _, userEv := range opts.UserEvents {
+			// Watch for ShortKislev and LongCheshvan
+			if userEv.Day <= hdate.DaysInMonth(userEv.Month, hyear) {
+				userEvents = append(userEvents, event.UserEvent{
+					Date: hdate.New(hyear, userEv.Month, userEv.Day),
+					Desc: userEv.Desc,
+				})
+			}
+		}
+		for _, birthday := range opts.Birthdays {
+			if ev, ok := makeBirthdayEvent(hyear, birthday, opts); ok {
+				userEvents = append(userEvents, ev)
+			}
+		}
+		c.userEvents = userEvents
+	}
+}
+
+// generateDay appends the events for day abs to events.
+func (c *Calendar) generateDay(abs int64, events []event.CalEvent) []event.CalEvent {
+	opts := c.opts
+	il := opts.IL
+	hd := hdate.FromRD(abs)
+	if hyear := hd.Year(); hyear != c.currentYear {
+		c.startYear(hyear)
+	}
+	dow := hd.Weekday()
+	prevEventsLength := len(events)
+	if opts.SunriseSunset && (!opts.WeeklyAbbreviated || dow == c.firstWeekday) {
+		events = append(events, riseSetEvent{date: hd, opts: opts})
+	}
+	if opts.DailySedra || (opts.Sedrot && dow == time.Saturday) {
+		parsha := c.sedraYear.LookupByRD(abs)
+		if !parsha.Chag {
+			events = append(events, event.NewParshaEvent(hd, parsha, il))
+		}
+	}
+	var candlesEv TimedEvent
+	for _, holidayEv := range c.holidaysYear {
+		if hd == holidayEv.Date {
+			events, candlesEv = appendHolidayAndRelated(events, candlesEv, holidayEv, opts)
+		}
+	}
+	// When Erev Pesach falls on Shabbat, burning chametz is moved to the
+	// Friday before (13 Nisan), since chametz cannot be burned on Shabbat.
+	if opts.CandleLighting && dow == time.Friday && hd.Month() == hdate.Nisan && hd.Day() == 13 {
+		if biurEv := makeBiurChametz(hd, opts); (biurEv != TimedEvent{}) {
+			events = append(events, biurEv)
+		}
+	}
+	for _, userEv := range c.userEvents {
+		if abs == userEv.GetDate().Abs() {
+			events = append(events, userEv)
+		}
+	}
+	if !opts.WeeklyAbbreviated || dow == c.firstWeekday {
+		if opts.Omer && abs >= c.beginOmer && abs <= c.endOmer {
+			omerDay := int(abs - c.beginOmer + 1)
+			events = append(events, omer.NewOmerEvent(hd, omerDay))
+		}
+		// Daily learning schedules (Daf Yomi, Mishna Yomi, Yerushalmi
+		// Yomi) are supplied by schedule providers that register
+		// themselves with the dailylearning package (e.g. by importing
+		// github.com/hebcal/learning). When no provider is registered,
+		// these lookups return nil and the events are simply omitted.
+		if opts.DafYomi {
+			if ev := dailylearning.Lookup("dafYomi", hd, il); ev != nil {
+				events = append(events, ev)
+			}
+		}
+		if opts.YerushalmiYomi {
+			if ev := dailylearning.Lookup(c.yerushalmiCalendar, hd, il); ev != nil {
+				events = append(events, ev)
+			}
+		}
+		if opts.MishnaYomi {
+			if ev := dailylearning.Lookup("mishnaYomi", hd, il); ev != nil {
+				events = append(events, ev)
+			}
+		}
+		if opts.NachYomi {
+			if ev := dailylearning.Lookup("nachYomi", hd, il); ev != nil {
+				events = append(events, ev)
+			}
+		}
+		// Any additional daily learning schedules requested by name
+		// (e.g. "929", "rambam1"). Names are case-insensitive and
+		// resolved through the dailylearning registry.
+		for _, name := range opts.DailyLearning {
+			if ev := dailylearning.Lookup(name, hd, il); ev != nil {
+				events = append(events, ev)
+			}
+		}
+		if opts.DailyZmanim {
+			zmanEvents := dailyZemanim(hd, opts)
+			events = append(events, zmanEvents...)
+		}
+	}
+	if (candlesEv == TimedEvent{}) && opts.CandleLighting && (dow == time.Friday || dow == time.Saturday) {
+		candlesEv = makeCandleEvent(hd, opts, nil)
+		// Link erev-Shabbat candle-lighting to this week's parsha (matching
+		// @hebcal/core), so consumers can render "Parashat X" as its memo.
+		if dow == time.Friday && opts.Sedrot && (candlesEv != TimedEvent{}) {
+			if parsha := c.sedraYear.LookupByRD(abs); !parsha.Chag {
+				candlesEv.LinkedEvent = event.NewParshaEvent(hd.OnOrAfter(time.Saturday), parsha, il)
+			}
+		}
+	}
+	if (candlesEv != TimedEvent{}) {
+		events = append(events, candlesEv)
+	}
+	if opts.Molad && dow == time.Saturday && hd.Month() != hdate.Elul && hd.Day() >= 23 && hd.Day() <= 29 {
+		nextMonthName, nextMonth := nextMonthName(hd.Year(), hd.Month())
+		molad := molad.New(hd.Year(), nextMonth)
+		cc := ""
+		if opts.Location != nil {
+			cc = opts.Location.CountryCode
+		}
+		events = append(events, event.NewMoladEvent(hd, molad, nextMonthName, cc))
+	}
+	for _, levanaEv := range c.levanaYear {
+		if hd == levanaEv.Date {
+			events = append(events, levanaEv)
+		}
+	}
+	for _, tekufaEv := range c.tekufotYear {
+		if hd == tekufaEv.Date {
+			events = append(events, tekufaEv)
+		}
+	}
+	if (opts.AddHebrewDates && (!opts.WeeklyAbbreviated || dow == c.firstWeekday)) ||
+		((opts.AddHebrewDates || opts.AddHebrewDatesForEvents) && prevEventsLength != len(events)) {
+		events = append(events, nil)
+		copy(events[prevEventsLength+1:], events[prevEventsLength:])
+		events[prevEventsLength] = event.NewHebrewDateEvent(hd)
+	}
+	return events
+}
@@ -0,0 +1,94 @@
+package hebcal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+func TestCalendarMatchesHebrewCalendar(t *testing.T) {
+	assert := assert.New(t)
+	newOpts := func() *hebcal.CalOptions {
+		return &hebcal.CalOptions{
+			Year:           2022,
+			NumYears:       2,
+			CandleLighting: true,
+			Location:       zmanim.LookupCity("Chicago"),
+			Sedrot:         true,
+			Omer:           true,
+			AddHebrewDates: true,
+		}
+	}
+	expected, err := hebcal.HebrewCalendar(newOpts())
+	assert.NoError(err)
+	cal, err := hebcal.NewCalendar(newOpts())
+	assert.NoError(err)
+	var actual []event.CalEvent
+	for {
+		ev, ok := cal.Next()
+		if !ok {
+			break
+		}
+		actual = append(actual, ev)
+	}
+	assert.Equal(len(expected), len(actual))
+	assert.Equal(expected, actual)
+	_, ok := cal.Next()
+	assert.False(ok)
+}
+
+func TestCalendarAllEarlyTermination(t *testing.T) {
+	assert := assert.New(t)
+	cal, err := hebcal.NewCalendar(&hebcal.CalOptions{Year: 5783, IsHebrewYear: true, NumYears: 1000})
+	assert.NoError(err)
+	var found event.CalEvent
+	cal.All()(func(ev event.CalEvent) bool {
+		if ev.Render("en") == "Chanukah: 1 Candle" {
+			found = ev
+			return false
+		}
+		return true
+	})
+	assert.Equal(hdate.New(5783, hdate.Kislev, 24), found.GetDate())
+	// The iterator resumes after the event at which it stopped.
+	ev, ok := cal.Next()
+	assert.True(ok)
+	assert.Equal("Chanukah: 2 Candles", ev.Render("en"))
+}
+
+func TestCalendarWalk(t *testing.T) {
+	assert := assert.New(t)
+	cal, _ := hebcal.NewCalendar(&hebcal.CalOptions{Year: 2023})
+	errStop := errors.New("stop")
+	count := 0
+	err := cal.Walk(context.Background(), func(ev event.CalEvent) error {
+		count++
+		if count == 3 {
+			return errStop
+		}
+		return nil
+	})
+	assert.Equal(errStop, err)
+	assert.Equal(3, count)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cal, _ = hebcal.NewCalendar(&hebcal.CalOptions{Year: 2023})
+	err = cal.Walk(ctx, func(ev event.CalEvent) error {
+		t.Fatal("unexpected event")
+		return nil
+	})
+	assert.Equal(context.Canceled, err)
+}
+
+func TestNewCalendarError(t *testing.T) {
+	_, err := hebcal.NewCalendar(&hebcal.CalOptions{CandleLighting: true})
+	assert.EqualError(t, err, "opts.CandleLighting requires opts.Location")
+}
@@ -45,6 +45,9 @@ type TimedEvent struct {
 	LinkedEvent  event.CalEvent
 	sunsetOffset int
 	opts         *CalOptions
+	// Hebrew label used when the "he" locale has no translation of Desc,
+	// as for most zmanim of the registry
+	hebrewDesc string
 }
 
 func NewTimedEvent(hd hdate.HDate, desc string, flags event.HolidayFlags, t time.Time,
@@ -105,7 +108,10 @@ func (ev TimedEvent) GetDate() hdate.HDate {
 }
 
 func (ev TimedEvent) Render(locale string) string {
-	desc, _ := locales.LookupTranslation(ev.Desc, locale)
+	desc, ok := locales.LookupTranslation(ev.Desc, locale)
+	if !ok && ev.hebrewDesc != "" && (locale == "he" || locale == "he-x-nonikud") {
+		desc = ev.hebrewDesc
+	}
 	if ev.Desc == "Havdalah" && ev.sunsetOffset != 0 {
 		minStr, _ := locales.LookupTranslation("min", locale)
 		desc = fmt.Sprintf("%s (%d %s)", desc, ev.sunsetOffset, minStr)
@@ -141,6 +147,10 @@ func (ev TimedEvent) GetCategories() []string {
 		return []string{"zmanim", "achilasChametz"}
 	case "Biur Chametz":
 		return []string{"zmanim", "biurChametz"}
+	case "Kiddush Levana begins", "Kiddush Levana ends":
+		return []string{"zmanim", "kiddushLevana"}
+	case "Tekufat Tishrei", "Tekufat Tevet", "Tekufat Nisan", "Tekufat Tammuz":
+		return []string{"tekufa"}
 	}
 	return []string{"unknown"}
 }
@@ -148,12 +158,14 @@ func (ev TimedEvent) GetCategories() []string {
 // newZmanim builds a Zmanim for the Gregorian date of hd at the options'
 // Location, honoring opts.UseElevation so that sunrise/sunset-based times
 // account for the location's elevation when requested. Degree-based zmanim are
-// never affected by elevation.
+// never affected by elevation. The polar fallback of opts is also applied.
 func newZmanim(hd hdate.HDate, opts *CalOptions) zmanim.Zmanim {
 	year, month, day := hd.Greg()
 	gregDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
 	z := zmanim.New(opts.Location, gregDate)
 	z.UseElevation = opts.UseElevation
+	z.PolarFallback = opts.PolarFallback
+	z.PolarLatitude = opts.PolarLatitude
 	return z
 }
 
@@ -323,31 +335,21 @@ func (ev riseSetEvent) GetCategories() []string {
 }
 
 func dailyZemanim(date hdate.HDate, opts *CalOptions) []event.CalEvent {
-	z := newZmanim(date, opts)
-	times := []struct {
-		desc string
-		t    time.Time
-	}{
-		{"Alot HaShachar", z.AlotHaShachar()},
-		{"Misheyakir", z.Misheyakir()},
-		{"Misheyakir Machmir", z.MisheyakirMachmir()},
-		{"Sunrise", z.Sunrise()},
-		{"Kriat Shema, sof zeman (MGA)", z.SofZmanShmaMGA()},
-		{"Kriat Shema, sof zeman (GRA)", z.SofZmanShma()},
-		{"Tefilah, sof zeman (MGA)", z.SofZmanTfillaMGA()},
-		{"Tefilah, sof zeman (GRA)", z.SofZmanTfilla()},
-		{"Chatzot HaYom", z.Chatzot()},
-		{"Mincha Gedolah", z.MinchaGedola()},
-		{"Mincha Ketanah", z.MinchaKetana()},
-		{"Plag HaMincha", z.PlagHaMincha()},
-		{"Sunset", z.Sunset()},
-		{"Bein HaShemashot", z.BeinHashmashos()},
-		{"Tzeit HaKochavim", z.Tzeit(zmanim.Tzeit3SmallStars)},
+	name := opts.ZmanimProfile
+	if name == "" {
+		name = zmanim.DefaultProfile
 	}
-	events := make([]event.CalEvent, 0, len(times))
-	for _, zman := range times {
-		if !zman.t.IsZero() {
-			ev := NewTimedEvent(date, zman.desc, event.ZMANIM, zman.t, 0, nil, opts)
+	profile, _ := zmanim.LookupProfile(name)
+	zmans, err := profile.Zmanim()
+	if err != nil {
+		return nil
+	}
+	z := newZmanim(date, opts)
+	events := make([]event.CalEvent, 0, len(zmans))
+	for _, zman := range zmans {
+		if t := zman.Calc(&z); !t.IsZero() {
+			ev := NewTimedEvent(date, zman.English, event.ZMANIM, t, 0, nil, opts)
+			ev.hebrewDesc = zman.Hebrew
 			events = append(events, ev)
 		}
 	}
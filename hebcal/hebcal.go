@@ -24,11 +24,8 @@ import (
 
 	"github.com/hebcal/greg"
 	"github.com/hebcal/hdate"
-	"github.com/hebcal/hebcal-go/dailylearning"
 	"github.com/hebcal/hebcal-go/event"
 	"github.com/hebcal/hebcal-go/molad"
-	"github.com/hebcal/hebcal-go/omer"
-	"github.com/hebcal/hebcal-go/sedra"
 	"github.com/hebcal/hebcal-go/zmanim"
 )
 
@@ -69,6 +66,9 @@ Additional non-default event types can be specified:
   - Shabbat Mevarchim HaChodesh on Saturday before Rosh Chodesh (opts.ShabbatMevarchim)
   - Molad announcement on Saturday before Rosh Chodesh (opts.Molad)
   - Yom Kippur Katan (opts.YomKippurKatan)
+  - Earliest and latest times for Kiddush Levana (opts.KiddushLevana)
+  - Tekufot, the four seasons of the year (opts.Tekufot)
+  - Hebrew birthdays, bar mitzvah and bat mitzvah (opts.Birthdays)
 
 Candle-lighting and Havdalah times are approximated using latitude and longitude
 specified by the Location class. The Location class contains a small
@@ -107,183 +107,24 @@ in the evening).
 Two options also exist for generating an Event with the Hebrew date:
   - opts.AddHebrewDates - print the Hebrew date for the entire date range
   - opts.AddHebrewDatesForEvents - print the Hebrew date for dates with some events
+
+HebrewCalendar returns every event in the range at once. To generate events
+one at a time, for example over a long range of NumYears or to stop at the
+first match, use NewCalendar instead.
 */
 func HebrewCalendar(opts *CalOptions) ([]event.CalEvent, error) {
-	err := checkCandleOptions(opts)
-	if err != nil {
-		return nil, err
-	}
-	if opts.SunriseSunset && opts.Location == nil {
-		return nil, errors.New("opts.SunriseSunset requires opts.Location")
-	}
-	if opts.DailyZmanim && opts.Location == nil {
-		return nil, errors.New("opts.DailyZmanim requires opts.Location")
-	}
-	startAbs, endAbs, err := getStartAndEnd(opts)
+	cal, err := NewCalendar(opts)
 	if err != nil {
 		return nil, err
 	}
-	if opts.Location != nil && opts.Location.CountryCode == "IL" {
-		opts.IL = true
-	}
-	opts.Mask = getMaskFromOptions(opts)
-	if opts.YerushalmiYomi && opts.YerushalmiEdition == 0 {
-		opts.YerushalmiEdition = Vilna
-	}
-	yerushalmiCalendar := "yerushalmi-vilna"
-	if opts.YerushalmiEdition == Schottenstein {
-		yerushalmiCalendar = "yerushalmi-schottenstein"
-	}
-	var (
-		il           = opts.IL
-		currentYear  = -1
-		holidaysYear []event.HolidayEvent
-		sedraYear    sedra.Sedra
-		beginOmer    int64
-		endOmer      int64
-		userEvents   []event.UserEvent
-	)
-	firstWeekday := time.Weekday(startAbs % 7)
 	events := make([]event.CalEvent, 0, 20)
-	for abs := startAbs; abs <= endAbs; abs++ {
-		hd := hdate.FromRD(abs)
-		hyear := hd.Year()
-		if hyear != currentYear {
-			currentYear = hyear
-			holidaysYear = GetHolidaysForYear(hyear, il)
-			if opts.Sedrot || opts.DailySedra {
-				sedraYear = sedra.New(hyear, il)
-			}
-			if opts.Omer {
-				beginOmer = hdate.ToRD(hyear, hdate.Nisan, 16)
-				endOmer = hdate.ToRD(hyear, hdate.Sivan, 5)
-			}
-			numUserEvents := len(opts.Yahrzeits) + len(opts.UserEvents)
-			if numUserEvents != 0 {
-				userEvents = make([]event.UserEvent, 0, numUserEvents)
-				for _, yahrzeit := range opts.Yahrzeits {
-					origDate := hdate.FromTime(yahrzeit.Date)
-					observedDate, err := hdate.GetYahrzeit(currentYear, origDate)
-					if err == nil {
-						userEvents = append(userEvents, event.UserEvent{
-							Date: observedDate,
-							Desc: yahrzeit.Name,
-						})
-					}
-				}
-				for _, userEv := range opts.UserEvents {
-					// Watch for ShortKislev and LongCheshvan
-					if userEv.Day <= hdate.DaysInMonth(userEv.Month, hyear) {
-						userEvents = append(userEvents, event.UserEvent{
-							Date: hdate.New(hyear, userEv.Month, userEv.Day),
-							Desc: userEv.Desc,
-						})
-					}
-				}
-			}
-		}
-		dow := hd.Weekday()
-		prevEventsLength := len(events)
-		if opts.SunriseSunset && (!opts.WeeklyAbbreviated || dow == firstWeekday) {
-			events = append(events, riseSetEvent{date: hd, opts: opts})
-		}
-		if opts.DailySedra || (opts.Sedrot && dow == time.Saturday) {
-			parsha := sedraYear.LookupByRD(abs)
-			if !parsha.Chag {
-				events = append(events, event.NewParshaEvent(hd, parsha, il))
-			}
-		}
-		var candlesEv TimedEvent
-		for _, holidayEv := range holidaysYear {
-			if hd == holidayEv.Date {
-				events, candlesEv = appendHolidayAndRelated(events, candlesEv, holidayEv, opts)
-			}
-		}
-		// When Erev Pesach falls on Shabbat, burning chametz is moved to the
-		// Friday before (13 Nisan), since chametz cannot be burned on Shabbat.
-		if opts.CandleLighting && dow == time.Friday && hd.Month() == hdate.Nisan && hd.Day() == 13 {
-			if biurEv := makeBiurChametz(hd, opts); (biurEv != TimedEvent{}) {
-				events = append(events, biurEv)
-			}
-		}
-		for _, userEv := range userEvents {
-			if abs == userEv.Date.Abs() {
-				events = append(events, userEv)
-			}
-		}
-		if !opts.WeeklyAbbreviated || dow == firstWeekday {
-			if opts.Omer && abs >= beginOmer && abs <= endOmer {
-				omerDay := int(abs - beginOmer + 1)
-				events = append(events, omer.NewOmerEvent(hd, omerDay))
-			}
-			// Daily learning schedules (Daf Yomi, Mishna Yomi, Yerushalmi
-			// Yomi) are supplied by schedule providers that register
-			// themselves with the dailylearning package (e.g. by importing
-			// github.com/hebcal/learning). When no provider is registered,
-			// these lookups return nil and the events are simply omitted.
-			if opts.DafYomi {
-				if ev := dailylearning.Lookup("dafYomi", hd, il); ev != nil {
-					events = append(events, ev)
-				}
-			}
-			if opts.YerushalmiYomi {
-				if ev := dailylearning.Lookup(yerushalmiCalendar, hd, il); ev != nil {
-					events = append(events, ev)
-				}
-			}
-			if opts.MishnaYomi {
-				if ev := dailylearning.Lookup("mishnaYomi", hd, il); ev != nil {
-					events = append(events, ev)
-				}
-			}
-			if opts.NachYomi {
-				if ev := dailylearning.Lookup("nachYomi", hd, il); ev != nil {
-					events = append(events, ev)
-				}
-			}
-			// Any additional daily learning schedules requested by name
-			// (e.g. "929", "rambam1"). Names are case-insensitive and
-			// resolved through the dailylearning registry.
-			for _, name := range opts.DailyLearning {
-				if ev := dailylearning.Lookup(name, hd, il); ev != nil {
-					events = append(events, ev)
-				}
-			}
-			if opts.DailyZmanim {
-				zmanEvents := dailyZemanim(hd, opts)
-				events = append(events, zmanEvents...)
-			}
-		}
-		if (candlesEv == TimedEvent{}) && opts.CandleLighting && (dow == time.Friday || dow == time.Saturday) {
-			candlesEv = makeCandleEvent(hd, opts, nil)
-			// Link erev-Shabbat candle-lighting to this week's parsha (matching
-			// @hebcal/core), so consumers can render "Parashat X" as its memo.
-			if dow == time.Friday && opts.Sedrot && (candlesEv != TimedEvent{}) {
-				if parsha := sedraYear.LookupByRD(abs); !parsha.Chag {
-					candlesEv.LinkedEvent = event.NewParshaEvent(hd.OnOrAfter(time.Saturday), parsha, il)
-				}
-			}
-		}
-		if (candlesEv != TimedEvent{}) {
-			events = append(events, candlesEv)
-		}
-		if opts.Molad && dow == time.Saturday && hd.Month() != hdate.Elul && hd.Day() >= 23 && hd.Day() <= 29 {
-			nextMonthName, nextMonth := nextMonthName(hd.Year(), hd.Month())
-			molad := molad.New(hd.Year(), nextMonth)
-			cc := ""
-			if opts.Location != nil {
-				cc = opts.Location.CountryCode
-			}
-			events = append(events, event.NewMoladEvent(hd, molad, nextMonthName, cc))
-		}
-		if (opts.AddHebrewDates && (!opts.WeeklyAbbreviated || dow == firstWeekday)) ||
-			((opts.AddHebrewDates || opts.AddHebrewDatesForEvents) && prevEventsLength != len(events)) {
-			events = append(events, nil)
-			copy(events[prevEventsLength+1:], events[prevEventsLength:])
-			events[prevEventsLength] = event.NewHebrewDateEvent(hd)
+	for {
+		ev, ok := cal.Next()
+		if !ok {
+			return events, nil
 		}
+		events = append(events, ev)
 	}
-	return events, nil
 }
 
 func getStartAndEnd(opts *CalOptions) (int64, int64, error) {
@@ -462,6 +303,12 @@ func getMaskFromOptions(opts *CalOptions) event.HolidayFlags {
 		if (m & event.YERUSHALMI_YOMI) != 0 {
 			opts.YerushalmiYomi = true
 		}
+		if (m & event.KIDDUSH_LEVANA) != 0 {
+			opts.KiddushLevana = true
+		}
+		if (m & event.TEKUFA) != 0 {
+			opts.Tekufot = true
+		}
 		return m
 	}
 	var mask event.HolidayFlags
@@ -518,6 +365,12 @@ func getMaskFromOptions(opts *CalOptions) event.HolidayFlags {
 	if opts.YomKippurKatan {
 		mask |= event.YOM_KIPPUR_KATAN
 	}
+	if opts.KiddushLevana {
+		mask |= event.KIDDUSH_LEVANA
+	}
+	if opts.Tekufot {
+		mask |= event.TEKUFA
+	}
 	return mask
 }
 
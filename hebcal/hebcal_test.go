@@ -513,6 +513,35 @@ func TestHebrewCalendarYahrzeit(t *testing.T) {
 	})
 }
 
+func TestUserYahrzeitAfterSunset(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.LookupCity("New York")
+	ny, _ := time.LoadLocation("America/New_York")
+	// sunset in New York on December 25, 2022 was at 4:35pm
+	before := time.Date(2022, time.December, 25, 16, 30, 0, 0, ny)
+	after := time.Date(2022, time.December, 25, 16, 40, 0, 0, ny)
+	assert.Equal(hdate.New(5783, hdate.Tevet, 1), hebcal.UserYahrzeit{Date: before}.HDate())
+	assert.Equal(hdate.New(5783, hdate.Tevet, 2), hebcal.UserYahrzeit{Date: before, AfterSunset: true}.HDate())
+	assert.Equal(hdate.New(5783, hdate.Tevet, 1), hebcal.UserYahrzeit{Date: before, Location: loc}.HDate())
+	assert.Equal(hdate.New(5783, hdate.Tevet, 2), hebcal.UserYahrzeit{Date: after, Location: loc}.HDate())
+	// the time of day is interpreted in the time zone of the location
+	assert.Equal(hdate.New(5783, hdate.Tevet, 2), hebcal.UserYahrzeit{Date: after.UTC(), Location: loc}.HDate())
+
+	opts := &hebcal.CalOptions{
+		Start:      hdate.New(5784, hdate.Tevet, 1),
+		End:        hdate.New(5784, hdate.Tevet, 3),
+		NoHolidays: true,
+		Yahrzeits: []hebcal.UserYahrzeit{
+			{Date: before, Name: "Before Sunset", Location: loc},
+			{Date: after, Name: "After Sunset", Location: loc},
+		},
+	}
+	checkEvents(t, "en", opts, []string{
+		"2023-12-13 Before Sunset",
+		"2023-12-14 After Sunset",
+	})
+}
+
 func TestHebrewCalendarInfile(t *testing.T) {
 	opts := &hebcal.CalOptions{
 		Start:                   hdate.New(5728, hdate.Tishrei, 1),
@@ -531,3 +560,99 @@ func TestHebrewCalendarInfile(t *testing.T) {
 		"1967-10-05 Ben Ploni",
 	})
 }
+
+func TestDailyZemanimProfile(t *testing.T) {
+	hd := hdate.New(5782, hdate.Kislev, 23)
+	opts := &hebcal.CalOptions{
+		Start:         hd,
+		End:           hd,
+		NoHolidays:    true,
+		DailyZmanim:   true,
+		ZmanimProfile: "sephardi-il",
+		Location:      zmanim.LookupCity("Providence"),
+		Hour24:        true,
+	}
+	events, err := hebcal.HebrewCalendar(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, len(events))
+	assert.Equal(t, "Alot HaShachar (72 min zmaniyot): 05:52", events[0].Render("en"))
+	assert.Equal(t, "Sunrise: 06:49", events[2].Render("en"))
+	opts.ZmanimProfile = "nusach-mars"
+	_, err = hebcal.HebrewCalendar(opts)
+	assert.EqualError(t, err, `unknown zmanim profile "nusach-mars"`)
+}
+
+func TestDailyZemanimProfileHebrew(t *testing.T) {
+	hd := hdate.New(5782, hdate.Kislev, 23)
+	opts := &hebcal.CalOptions{
+		Start:       hd,
+		End:         hd,
+		NoHolidays:  true,
+		DailyZmanim: true,
+		Location:    zmanim.LookupCity("Providence"),
+		Hour24:      true,
+	}
+	for _, profile := range []string{"chabad", "sephardi-il", "yeshivish"} {
+		opts.ZmanimProfile = profile
+		events, err := hebcal.HebrewCalendar(opts)
+		assert.NoError(t, err)
+		for _, ev := range events {
+			assert.NotRegexp(t, "[A-Za-z]", ev.Render("he"), profile)
+		}
+		if profile == "chabad" {
+			assert.Equal(t, "Alot HaShachar (Baal HaTanya): 05:16", events[0].Render("en"))
+			assert.Equal(t, "עלות השחר (בעל התניא): 05:16", events[0].Render("he"))
+		}
+	}
+}
+
+func TestCandleLightingPolarFallback(t *testing.T) {
+	assert := assert.New(t)
+	tromso := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	opts := &hebcal.CalOptions{
+		Start:          hdate.FromGregorian(2024, time.June, 21),
+		End:            hdate.FromGregorian(2024, time.June, 22),
+		NoHolidays:     true,
+		CandleLighting: true,
+		Location:       &tromso,
+	}
+	events, err := hebcal.HebrewCalendar(opts)
+	assert.NoError(err)
+	assert.Equal(0, len(events))
+
+	opts.PolarFallback = zmanim.PolarFixedMinutes
+	events, err = hebcal.HebrewCalendar(opts)
+	assert.NoError(err)
+	assert.Equal(2, len(events))
+	assert.Equal("Candle lighting: 6:26", events[0].Render("en"))
+	assert.Equal("Havdalah: 7:20", events[1].Render("en"))
+}
+
+func TestHavdalahPolarNearestDay(t *testing.T) {
+	assert := assert.New(t)
+	tromso := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	events, err := hebcal.HebrewCalendar(&hebcal.CalOptions{
+		Start:          hdate.FromGregorian(2024, time.June, 1),
+		End:            hdate.FromGregorian(2024, time.June, 30),
+		NoHolidays:     true,
+		CandleLighting: true,
+		HavdalahDeg:    zmanim.Tzeit3SmallStars,
+		Location:       &tromso,
+		PolarFallback:  zmanim.PolarNearestDay,
+	})
+	assert.NoError(err)
+	// four Shabbatot and Shavuot
+	assert.Equal(12, len(events))
+	for _, ev := range events {
+		timed := ev.(hebcal.TimedEvent)
+		greg := timed.Date.Gregorian()
+		z := zmanim.New(&tromso, greg)
+		z.PolarFallback = zmanim.PolarNearestDay
+		sunset := z.Sunset()
+		if timed.Desc == "Havdalah" || timed.Flags&event.LIGHT_CANDLES_TZEIS != 0 {
+			assert.True(timed.EventTime.After(sunset), "%s %v", timed.Render("en"), sunset)
+		} else {
+			assert.True(timed.EventTime.Before(sunset), "%s %v", timed.Render("en"), sunset)
+		}
+	}
+}
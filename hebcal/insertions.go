@@ -0,0 +1,125 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/tekufot"
+)
+
+// PrayerInsertions reports which seasonal and holiday changes to the
+// daily prayers apply on a Hebrew date. Since the Hebrew day begins at
+// nightfall, each field applies from Maariv of the evening before.
+type PrayerInsertions struct {
+	// "Mashiv HaRuach uMorid HaGeshem" is said in the second blessing of
+	// the Amidah, from Musaf of Shemini Atzeret until Musaf of the first
+	// day of Pesach. When false, "Morid HaTal" is said instead by those
+	// whose custom is to say it. On Shemini Atzeret this is false and on
+	// the first day of Pesach true, matching Maariv and Shacharit.
+	MashivHaRuach bool
+	// "V'ten Tal u'Matar Livracha" replaces "V'ten Bracha" in the ninth
+	// blessing of the weekday Amidah, from 7 Cheshvan in Israel, or in the
+	// Diaspora from the 60th day after Tekufat Tishrei, until Pesach.
+	TalUMatar bool
+	// Ya'aleh v'Yavo is said on Rosh Chodesh, Rosh Hashana, Yom Kippur,
+	// Pesach, Shavuot, Sukkot and Shemini Atzeret.
+	YaalehVeyavo bool
+	// Al HaNisim is said on Chanukah and Purim. Where Purim is celebrated
+	// on the 15th (Jerusalem), it is said on Shushan Purim instead; this
+	// reports the 14th in both Israel and the Diaspora.
+	AlHaNisim bool
+	// Aneinu is said on public fast days other than Yom Kippur.
+	Aneinu bool
+	// Avinu Malkeinu is said during the Ten Days of Repentance and on
+	// minor fast days, except on Shabbat (when Yom Kippur falls on
+	// Shabbat, it is said only at Ne'ilah) and on Erev Yom Kippur, unless
+	// Erev Yom Kippur is a Friday (when it is said at Shacharit).
+	AvinuMalkeinu bool
+	// During the Ten Days of Repentance (Aseret Yemei Teshuva), from Rosh
+	// Hashana through Yom Kippur, the Amidah concludes "HaMelech
+	// HaKadosh" and "HaMelech HaMishpat", and adds Zochreinu, Mi Chamocha,
+	// U'chtov and B'sefer Chayim.
+	AseretYemeiTeshuva bool
+}
+
+// GetPrayerInsertions returns the changes to the daily prayers for the
+// given Hebrew date, for either the Diaspora (il=false) or Israel (il=true).
+func GetPrayerInsertions(hd hdate.HDate, il bool) PrayerInsertions {
+	year := hd.Year()
+	abs := hd.Abs()
+	month := hd.Month()
+	day := hd.Day()
+	result := PrayerInsertions{}
+
+	// Both rain insertions end with Pesach; Mashiv HaRuach begins on
+	// Shemini Atzeret and V'ten Tal u'Matar somewhat later in the year.
+	pesach := hdate.New(year, hdate.Nisan, 15).Abs()
+	result.MashivHaRuach = abs > hdate.New(year, hdate.Tishrei, 22).Abs() && abs <= pesach
+	result.TalUMatar = abs >= tekufot.TalUMatarStart(year, il).Abs() && abs < pesach
+
+	result.AseretYemeiTeshuva = month == hdate.Tishrei && day <= 10
+
+	lastDay := func(ilDay, chulDay int) int {
+		if il {
+			return ilDay
+		}
+		return chulDay
+	}
+	switch {
+	case day == 1 || day == 30:
+		result.YaalehVeyavo = true // Rosh Chodesh (and Rosh Hashana)
+	case month == hdate.Tishrei:
+		result.YaalehVeyavo = day == 2 || day == 10 || (day >= 15 && day <= lastDay(22, 23))
+	case month == hdate.Nisan:
+		result.YaalehVeyavo = day >= 15 && day <= lastDay(21, 22)
+	case month == hdate.Sivan:
+		result.YaalehVeyavo = day >= 6 && day <= lastDay(6, 7)
+	}
+
+	chanukah := hdate.New(year, hdate.Kislev, 25).Abs()
+	purimMonth := hdate.Adar1
+	if hdate.IsLeapYear(year) {
+		purimMonth = hdate.Adar2
+	}
+	result.AlHaNisim = (abs >= chanukah && abs <= chanukah+7) ||
+		(month == purimMonth && day == 14)
+
+	isShabbat := hd.Weekday() == time.Saturday
+	for _, ev := range GetHolidaysOnDate(hd, il) {
+		switch {
+		case ev.Desc == "Yom Kippur":
+			result.AvinuMalkeinu = true
+		case ev.Flags&event.MAJOR_FAST != 0:
+			result.Aneinu = true // Tish'a B'Av
+		case ev.Flags&event.MINOR_FAST != 0 && ev.Flags&event.YOM_KIPPUR_KATAN == 0 &&
+			ev.Desc != "Ta'anit Bechorot":
+			result.Aneinu = true
+			result.AvinuMalkeinu = true
+		}
+	}
+	isErevYomKippur := month == hdate.Tishrei && day == 9
+	if result.AseretYemeiTeshuva && !isShabbat && (!isErevYomKippur || hd.Weekday() == time.Friday) {
+		result.AvinuMalkeinu = true
+	}
+	if isShabbat && !(month == hdate.Tishrei && day == 10) {
+		result.AvinuMalkeinu = false
+	}
+	return result
+}
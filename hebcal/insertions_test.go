@@ -0,0 +1,112 @@
+package hebcal_test
+
+import (
+	"testing"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/hebcal"
+)
+
+func TestPrayerInsertionsRain(t *testing.T) {
+	assert := assert.New(t)
+	sheminiAtzeret := hdate.New(5783, hdate.Tishrei, 22)
+	assert.False(hebcal.GetPrayerInsertions(sheminiAtzeret, false).MashivHaRuach)
+	assert.True(hebcal.GetPrayerInsertions(sheminiAtzeret.Next(), false).MashivHaRuach)
+	pesach := hdate.New(5783, hdate.Nisan, 15)
+	assert.True(hebcal.GetPrayerInsertions(pesach, false).MashivHaRuach)
+	assert.False(hebcal.GetPrayerInsertions(pesach.Next(), false).MashivHaRuach)
+
+	// Israel: 7 Cheshvan
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Cheshvan, 6), true).TalUMatar)
+	assert.True(hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Cheshvan, 7), true).TalUMatar)
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Cheshvan, 7), false).TalUMatar)
+	// Diaspora: first said at Maariv on the evening of December 4, 2022
+	assert.False(hebcal.GetPrayerInsertions(hdate.FromGregorian(2022, 12, 4), false).TalUMatar)
+	assert.True(hebcal.GetPrayerInsertions(hdate.FromGregorian(2022, 12, 5), false).TalUMatar)
+	assert.True(hebcal.GetPrayerInsertions(pesach.Prev(), false).TalUMatar)
+	assert.False(hebcal.GetPrayerInsertions(pesach, false).TalUMatar)
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Sivan, 1), true).TalUMatar)
+}
+
+func TestPrayerInsertionsYaalehVeyavo(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hd       hdate.HDate
+		il       bool
+		expected bool
+	}{
+		{hdate.New(5783, hdate.Tishrei, 1), false, true},
+		{hdate.New(5783, hdate.Tishrei, 3), false, false},
+		{hdate.New(5783, hdate.Tishrei, 10), false, true},
+		{hdate.New(5783, hdate.Tishrei, 23), false, true},
+		{hdate.New(5783, hdate.Tishrei, 23), true, false},
+		{hdate.New(5783, hdate.Cheshvan, 30), false, true},
+		{hdate.New(5783, hdate.Kislev, 1), false, true},
+		{hdate.New(5783, hdate.Kislev, 2), false, false},
+		{hdate.New(5783, hdate.Nisan, 18), true, true},
+		{hdate.New(5783, hdate.Nisan, 22), true, false},
+		{hdate.New(5783, hdate.Nisan, 22), false, true},
+		{hdate.New(5783, hdate.Sivan, 7), false, true},
+		{hdate.New(5783, hdate.Sivan, 7), true, false},
+	}
+	for _, test := range tests {
+		assert.Equal(test.expected, hebcal.GetPrayerInsertions(test.hd, test.il).YaalehVeyavo, test.hd.String())
+	}
+}
+
+func TestPrayerInsertionsAlHaNisim(t *testing.T) {
+	assert := assert.New(t)
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5784, hdate.Kislev, 24), false).AlHaNisim)
+	assert.True(hebcal.GetPrayerInsertions(hdate.New(5784, hdate.Kislev, 25), false).AlHaNisim)
+	// 5784 has a short Kislev, so Chanukah ends on 3 Tevet
+	assert.True(hebcal.GetPrayerInsertions(hdate.New(5784, hdate.Tevet, 3), false).AlHaNisim)
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5784, hdate.Tevet, 4), false).AlHaNisim)
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Tevet, 3), false).AlHaNisim)
+	assert.True(hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Adar1, 14), false).AlHaNisim)
+	// In a leap year, Purim is in Adar II
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5784, hdate.Adar1, 14), false).AlHaNisim)
+	assert.True(hebcal.GetPrayerInsertions(hdate.New(5784, hdate.Adar2, 14), false).AlHaNisim)
+}
+
+func TestPrayerInsertionsFasts(t *testing.T) {
+	assert := assert.New(t)
+	// Tzom Gedaliah, during the Ten Days of Repentance
+	ins := hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Tishrei, 3), false)
+	assert.Equal(hebcal.PrayerInsertions{
+		Aneinu:             true,
+		AvinuMalkeinu:      true,
+		AseretYemeiTeshuva: true,
+	}, ins)
+	// Yom Kippur 5783 was on Wednesday
+	ins = hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Tishrei, 10), false)
+	assert.Equal(hebcal.PrayerInsertions{
+		YaalehVeyavo:       true,
+		AvinuMalkeinu:      true,
+		AseretYemeiTeshuva: true,
+	}, ins)
+	// Erev Yom Kippur 5784 was on Sunday: no Avinu Malkeinu
+	ins = hebcal.GetPrayerInsertions(hdate.New(5784, hdate.Tishrei, 9), false)
+	assert.True(ins.AseretYemeiTeshuva)
+	assert.False(ins.AvinuMalkeinu)
+	// Erev Yom Kippur 5785 was on Friday: said at Shacharit
+	ins = hebcal.GetPrayerInsertions(hdate.New(5785, hdate.Tishrei, 9), false)
+	assert.True(ins.AvinuMalkeinu)
+	// Shabbat Shuva: no Avinu Malkeinu
+	ins = hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Tishrei, 6), false)
+	assert.True(ins.AseretYemeiTeshuva)
+	assert.False(ins.AvinuMalkeinu)
+	// Tish'a B'Av: Aneinu but no Avinu Malkeinu
+	ins = hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Av, 9), false)
+	assert.True(ins.Aneinu)
+	assert.False(ins.AvinuMalkeinu)
+	// Tzom Tammuz 5782 was postponed from Shabbat to Sunday 18 Tammuz
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5782, hdate.Tamuz, 17), false).Aneinu)
+	assert.True(hebcal.GetPrayerInsertions(hdate.New(5782, hdate.Tamuz, 18), false).Aneinu)
+	// Ta'anit Bechorot
+	assert.False(hebcal.GetPrayerInsertions(hdate.New(5783, hdate.Nisan, 14), false).Aneinu)
+	// Yom Kippur Katan is a private custom, not a public fast
+	assert.Equal(hebcal.PrayerInsertions{MashivHaRuach: true, TalUMatar: true},
+		hebcal.GetPrayerInsertions(hdate.New(5784, hdate.Shvat, 29), false))
+}
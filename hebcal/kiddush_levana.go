@@ -0,0 +1,76 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/molad"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+// jerusalemTimeZone returns the time zone in which times calculated in
+// Jerusalem mean time, such as Kiddush Levana and the tekufot, are
+// reported: the Location's, or Jerusalem's if there is none.
+func jerusalemTimeZone(opts *CalOptions) *time.Location {
+	tzid := "Asia/Jerusalem"
+	if opts.Location != nil {
+		tzid = opts.Location.TimeZoneId
+	}
+	if tz, err := zmanim.LoadLocation(tzid); err == nil {
+		return tz
+	}
+	return time.UTC
+}
+
+// makeKiddushLevanaEvents returns the "Kiddush Levana begins" and "Kiddush
+// Levana ends" events for every month of Hebrew year hyear. The earliest
+// time is rounded up and the latest time rounded down to the minute, so
+// that the displayed window never exceeds the actual one.
+func makeKiddushLevanaEvents(hyear int, opts *CalOptions) []TimedEvent {
+	tz := jerusalemTimeZone(opts)
+	numMonths := hdate.MonthsInYear(hyear)
+	events := make([]TimedEvent, 0, 2*numMonths)
+	for month := 1; month <= numMonths; month++ {
+		m := molad.New(hyear, hdate.HMonth(month))
+		start := m.TchilasZmanKidushLevana3Days()
+		if opts.KiddushLevana7Days {
+			start = m.TchilasZmanKidushLevana7Days()
+		}
+		end := m.SofZmanKidushLevanaBetweenMoldos()
+		if opts.KiddushLevana15Days {
+			end = m.SofZmanKidushLevana15Days()
+		}
+		if truncated := start.Truncate(time.Minute); !truncated.Equal(start) {
+			start = truncated.Add(time.Minute)
+		}
+		end = end.Truncate(time.Minute)
+		events = append(events,
+			newKiddushLevanaEvent("Kiddush Levana begins", start.In(tz), opts),
+			newKiddushLevanaEvent("Kiddush Levana ends", end.In(tz), opts))
+	}
+	return events
+}
+
+func newKiddushLevanaEvent(desc string, t time.Time, opts *CalOptions) TimedEvent {
+	hd := hdate.FromGregorian(t.Year(), t.Month(), t.Day())
+	ev := NewTimedEvent(hd, desc, event.KIDDUSH_LEVANA, t, 0, nil, opts)
+	ev.Emoji = "🌙"
+	return ev
+}
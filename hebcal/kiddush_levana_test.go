@@ -0,0 +1,56 @@
+package hebcal_test
+
+import (
+	"testing"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+func TestKiddushLevana(t *testing.T) {
+	assert := assert.New(t)
+	opts := &hebcal.CalOptions{
+		Start:         hdate.New(5783, hdate.Tishrei, 1),
+		End:           hdate.New(5783, hdate.Tishrei, 30),
+		KiddushLevana: true,
+		NoHolidays:    true,
+		Location:      zmanim.LookupCity("New York"),
+	}
+	events, err := hebcal.HebrewCalendar(opts)
+	assert.NoError(err)
+	actual := make([]string, 0, len(events))
+	for _, ev := range events {
+		actual = append(actual, hd2iso(ev.GetDate())+" "+ev.Render("en"))
+	}
+	assert.Equal([]string{
+		"2022-09-28 Kiddush Levana begins: 2:40",
+		"2022-10-10 Kiddush Levana ends: 9:01",
+	}, actual)
+	assert.Equal(event.KIDDUSH_LEVANA, events[0].GetFlags())
+	assert.Equal([]string{"zmanim", "kiddushLevana"}, events[0].GetCategories())
+
+	opts = &hebcal.CalOptions{
+		Start:               hdate.New(5783, hdate.Tishrei, 1),
+		End:                 hdate.New(5783, hdate.Tishrei, 30),
+		KiddushLevana:       true,
+		KiddushLevana7Days:  true,
+		KiddushLevana15Days: true,
+		NoHolidays:          true,
+		Hour24:              true,
+	}
+	events, err = hebcal.HebrewCalendar(opts)
+	assert.NoError(err)
+	actual = actual[:0]
+	for _, ev := range events {
+		actual = append(actual, hd2iso(ev.GetDate())+" "+ev.Render("en"))
+	}
+	// No location: times are in Israel (IDT, UTC+3)
+	assert.Equal([]string{
+		"2022-10-02 Kiddush Levana begins: 21:40",
+		"2022-10-10 Kiddush Levana ends: 21:39",
+	}, actual)
+}
@@ -0,0 +1,190 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+)
+
+// LuachRow is one row of a table of candle-lighting times: a Shabbat or
+// Yom Tov, from candle lighting on the eve to havdalah at its end. When
+// Yom Tov and Shabbat follow one another, they share a single row.
+type LuachRow struct {
+	// Date of the first candle lighting (or of havdalah, if the table
+	// begins on Shabbat or Yom Tov)
+	Date hdate.HDate
+	// Candle lighting on the eve. Zero if the table begins on Shabbat or
+	// Yom Tov.
+	CandleLighting TimedEvent
+	// Candle lighting on the following nights of a Yom Tov or of a Shabbat
+	// adjoining Yom Tov
+	MoreCandleLighting []TimedEvent
+	// Havdalah at the end. Zero if the table ends before havdalah.
+	Havdalah TimedEvent
+	// Weekly Torah portion, or nil if none is read
+	Parsha event.CalEvent
+	// Special Shabbat, such as Shabbat Zachor, or nil
+	SpecialShabbat event.CalEvent
+	// Yom Tov, Chol HaMoed and Rosh Chodesh days within the row
+	Holidays []event.CalEvent
+}
+
+// Luach is a table of candle-lighting and havdalah times for a location,
+// one row per Shabbat and Yom Tov: the "luach" printed by synagogues.
+type Luach struct {
+	Rows []LuachRow
+	opts *CalOptions
+}
+
+// luachHolidays are the holidays listed in a LuachRow.
+const luachHolidays = event.CHAG | event.CHOL_HAMOED | event.ROSH_CHODESH
+
+// GetLuach returns the candle-lighting table for the date range and
+// location of opts (opts.Location is required). The candle-lighting,
+// havdalah, Israel and Rosh Chodesh options of opts are honored; other
+// event types are ignored.
+func GetLuach(opts *CalOptions) (Luach, error) {
+	if opts == nil || opts.Location == nil {
+		return Luach{}, errors.New("luach requires a Location")
+	}
+	luachOpts := &CalOptions{
+		Location:           opts.Location,
+		Year:               opts.Year,
+		IsHebrewYear:       opts.IsHebrewYear,
+		NoJulian:           opts.NoJulian,
+		Month:              opts.Month,
+		NumYears:           opts.NumYears,
+		Start:              opts.Start,
+		End:                opts.End,
+		CandleLighting:     true,
+		UseElevation:       opts.UseElevation,
+		CandleLightingMins: opts.CandleLightingMins,
+		HavdalahMins:       opts.HavdalahMins,
+		HavdalahDeg:        opts.HavdalahDeg,
+		Sedrot:             true,
+		IL:                 opts.IL,
+		NoMinorFast:        true,
+		NoModern:           true,
+		NoRoshChodesh:      opts.NoRoshChodesh,
+		NoSpecialShabbat:   opts.NoSpecialShabbat,
+		Hour24:             opts.Hour24,
+	}
+	events, err := HebrewCalendar(luachOpts)
+	if err != nil {
+		return Luach{}, err
+	}
+	luach := Luach{opts: luachOpts}
+	var row *LuachRow
+	// events seen outside of a row, in case the table begins on Shabbat
+	// or Yom Tov and they belong to a row without candle lighting
+	var pending []event.CalEvent
+	for _, ev := range events {
+		if timed, ok := ev.(TimedEvent); ok {
+			switch timed.Desc {
+			case "Candle lighting":
+				if row == nil {
+					row = &LuachRow{Date: timed.Date, CandleLighting: timed}
+				} else {
+					row.MoreCandleLighting = append(row.MoreCandleLighting, timed)
+				}
+			case "Havdalah":
+				if row == nil {
+					row = &LuachRow{Date: timed.Date}
+					for _, ev := range pending {
+						if ev.GetDate() == timed.Date {
+							row.add(ev)
+						}
+					}
+				}
+				row.Havdalah = timed
+				luach.Rows = append(luach.Rows, *row)
+				row = nil
+			}
+			pending = nil
+			continue
+		}
+		if row == nil {
+			pending = append(pending, ev)
+		} else {
+			row.add(ev)
+		}
+	}
+	if row != nil {
+		luach.Rows = append(luach.Rows, *row)
+	}
+	return luach, nil
+}
+
+// add adds ev to the row if it is a parsha, special Shabbat or holiday.
+func (row *LuachRow) add(ev event.CalEvent) {
+	flags := ev.GetFlags()
+	switch {
+	case (flags & event.PARSHA_HASHAVUA) != 0:
+		row.Parsha = ev
+	case (flags & event.SPECIAL_SHABBAT) != 0:
+		row.SpecialShabbat = ev
+	case (flags & luachHolidays) != 0:
+		row.Holidays = append(row.Holidays, ev)
+	}
+}
+
+// Title renders the parsha, special Shabbat and holidays of the row,
+// e.g. "Parashat Tetzaveh, Shabbat Zachor".
+func (row LuachRow) Title(locale string) string {
+	var titles []string
+	if row.Parsha != nil {
+		titles = append(titles, row.Parsha.Render(locale))
+	}
+	if row.SpecialShabbat != nil {
+		titles = append(titles, row.SpecialShabbat.Render(locale))
+	}
+	for _, ev := range row.Holidays {
+		titles = append(titles, ev.Render(locale))
+	}
+	return strings.Join(titles, ", ")
+}
+
+// WriteText writes the table to w as plain text, one line per row with
+// the Gregorian date of the eve, the candle-lighting and havdalah times
+// and the row's Title:
+//
+//	Fri 24 Feb 2023  5:16  6:18  Parashat Terumah
+func (l Luach) WriteText(w io.Writer, locale string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, row := range l.Rows {
+		candles, havdalah := "", ""
+		if (row.CandleLighting != TimedEvent{}) {
+			candles = formatTime(row.CandleLighting.EventTime, l.opts)
+		}
+		if (row.Havdalah != TimedEvent{}) {
+			havdalah = formatTime(row.Havdalah.EventTime, l.opts)
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			row.Date.Gregorian().Format(reportDate), candles, havdalah,
+			row.Title(locale)); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
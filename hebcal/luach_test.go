@@ -0,0 +1,83 @@
+package hebcal_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+func TestGetLuach(t *testing.T) {
+	assert := assert.New(t)
+	luach, err := hebcal.GetLuach(&hebcal.CalOptions{
+		Start:    hdate.New(5783, hdate.Elul, 29),
+		End:      hdate.New(5784, hdate.Tishrei, 14),
+		Location: zmanim.LookupCity("Chicago"),
+	})
+	assert.NoError(err)
+	assert.Equal(4, len(luach.Rows))
+	// Rosh Hashana, from Friday through Shabbat and Sunday
+	rh := luach.Rows[0]
+	assert.Equal(hdate.New(5783, hdate.Elul, 29), rh.Date)
+	assert.Equal("Candle lighting: 6:42", rh.CandleLighting.Render("en"))
+	assert.Equal(1, len(rh.MoreCandleLighting))
+	assert.Equal(hdate.New(5784, hdate.Tishrei, 1), rh.MoreCandleLighting[0].Date)
+	assert.Equal(hdate.New(5784, hdate.Tishrei, 2), rh.Havdalah.Date)
+	assert.Equal("Havdalah: 7:38", rh.Havdalah.Render("en"))
+	assert.Nil(rh.Parsha)
+	assert.Equal("Rosh Hashana 5784, Rosh Hashana II", rh.Title("en"))
+	// Shabbat Shuva
+	assert.Equal("Parashat Ha'azinu, Shabbat Shuva", luach.Rows[1].Title("en"))
+	assert.Equal(time.Friday, luach.Rows[1].Date.Weekday())
+	assert.Equal("Yom Kippur", luach.Rows[2].Title("en"))
+	// the table ends before Sukkot
+	assert.Equal(hebcal.TimedEvent{}, luach.Rows[3].Havdalah)
+	assert.Equal("", luach.Rows[3].Title("en"))
+}
+
+func TestGetLuachPartialRows(t *testing.T) {
+	assert := assert.New(t)
+	// begins on Shabbat and ends on Friday
+	luach, err := hebcal.GetLuach(&hebcal.CalOptions{
+		Start:    hdate.New(5784, hdate.Cheshvan, 6),
+		End:      hdate.New(5784, hdate.Cheshvan, 12),
+		Location: zmanim.LookupCity("Jerusalem"),
+		IL:       true,
+	})
+	assert.NoError(err)
+	assert.Equal(2, len(luach.Rows))
+	assert.Equal(hebcal.TimedEvent{}, luach.Rows[0].CandleLighting)
+	assert.Equal(hdate.New(5784, hdate.Cheshvan, 6), luach.Rows[0].Havdalah.Date)
+	assert.Equal("Parashat Noach", luach.Rows[0].Title("en"))
+	assert.Equal(hebcal.TimedEvent{}, luach.Rows[1].Havdalah)
+	assert.Equal(hdate.New(5784, hdate.Cheshvan, 12), luach.Rows[1].CandleLighting.Date)
+}
+
+func TestGetLuachRequiresLocation(t *testing.T) {
+	_, err := hebcal.GetLuach(&hebcal.CalOptions{Year: 2023})
+	assert.Error(t, err)
+}
+
+func TestLuachWriteText(t *testing.T) {
+	assert := assert.New(t)
+	luach, err := hebcal.GetLuach(&hebcal.CalOptions{
+		Year:     2023,
+		Month:    time.February,
+		Location: zmanim.LookupCity("Chicago"),
+		Hour24:   true,
+	})
+	assert.NoError(err)
+	var sb strings.Builder
+	assert.NoError(luach.WriteText(&sb, "en"))
+	expected := `Fri 3 Feb 2023   16:50  17:53  Parashat Beshalach, Shabbat Shirah
+Fri 10 Feb 2023  16:59  18:01  Parashat Yitro
+Fri 17 Feb 2023  17:08  18:09  Parashat Mishpatim, Shabbat Shekalim
+Fri 24 Feb 2023  17:16  18:18  Parashat Terumah
+`
+	assert.Equal(expected, sb.String())
+}
@@ -0,0 +1,245 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+)
+
+const (
+	shivaDays    = 7
+	shloshimDays = 30
+	// a festival counts as seven days toward shloshim
+	festivalDays = 7
+)
+
+// Avelut is the timeline of mourning (avelut) for a death.
+//
+// Shiva and shloshim are counted from the day of burial, which is the first
+// day of both. A festival (Rosh Hashana, Yom Kippur, Pesach, Shavuot or
+// Sukkot) that begins during shiva cancels the rest of it; the festival
+// then counts as seven days toward shloshim (Sukkot and Shmini Atzeret as
+// fourteen), and the remaining days are counted after the festival. A
+// festival that begins after shiva is complete cancels the rest of
+// shloshim. When burial takes place during a festival, shiva begins after
+// it. In the Diaspora, the last day of the festival counts as the first day
+// of mourning.
+type Avelut struct {
+	Death  hdate.HDate // Hebrew date of death
+	Burial hdate.HDate // Hebrew date of burial
+	// First day of shiva: the day of burial, or the end of the festival
+	// during which burial took place
+	ShivaBegins hdate.HDate
+	// Last day of shiva, on which mourning ends after Shacharit
+	ShivaEnds hdate.HDate
+	// A festival cancelled the rest of shiva
+	ShivaCancelled bool
+	// Last day of shloshim, the thirty days of mourning
+	ShloshimEnds hdate.HDate
+	// A festival cancelled the rest of shloshim
+	ShloshimCancelled bool
+	// Last day of the Mourner's Kaddish for a parent, said for eleven
+	// months (less a day) from the death
+	KaddishEnds hdate.HDate
+	// Last day of the twelve months of mourning for a parent, counted
+	// from the burial
+	TwelveMonthsEnd hdate.HDate
+	// First yahrzeit, the anniversary of the death
+	FirstYahrzeit hdate.HDate
+}
+
+// GetAvelut returns the timeline of mourning for a death on the Hebrew date
+// death and burial on the Hebrew date burial, for either the Diaspora
+// (il=false) or Israel (il=true). If burial is the zero HDate, burial is on
+// the day of death.
+//
+// See UserYahrzeit.Avelut to calculate the timeline from a Gregorian date
+// and location.
+func GetAvelut(death, burial hdate.HDate, il bool) Avelut {
+	if burial == (hdate.HDate{}) || burial.Abs() < death.Abs() {
+		burial = death
+	}
+	b := burial.Abs()
+	fests := getFestivals(burial.Year(), il)
+	shivaBegins := b
+	for _, f := range fests {
+		if f.first <= b && b <= f.last {
+			shivaBegins = f.countFrom
+			break
+		}
+	}
+	a := Avelut{Death: death, Burial: burial}
+	shivaEnds := shivaBegins + shivaDays - 1
+	shloshimEnds := b + shloshimDays - 1
+	// shloshim is cancelled only by a festival after shiva is complete
+	complete := shivaEnds
+	for _, f := range fests {
+		if f.first > shivaBegins && f.first <= shivaEnds {
+			a.ShivaCancelled = true
+			shivaEnds = f.first - 1
+			shloshimEnds = f.countFrom + int64(shloshimDays-shivaDays-f.days) - 1
+			complete = f.last
+			break
+		}
+	}
+	for _, f := range fests {
+		if f.first > complete && f.first <= shloshimEnds {
+			a.ShloshimCancelled = true
+			shloshimEnds = f.first - 1
+			break
+		}
+	}
+	a.ShivaBegins = hdate.FromRD(shivaBegins)
+	a.ShivaEnds = hdate.FromRD(shivaEnds)
+	a.ShloshimEnds = hdate.FromRD(shloshimEnds)
+	a.KaddishEnds = addMonths(death, 11).Prev()
+	a.TwelveMonthsEnd = addMonths(burial, 12).Prev()
+	a.FirstYahrzeit, _ = hdate.GetYahrzeit(death.Year()+1, death)
+	return a
+}
+
+// Avelut returns the timeline of mourning for y, with burial on the
+// Gregorian date of burial, or y.Burial if burial is zero, or otherwise on
+// the day of death. Festivals are observed as in Israel if y.Location is in
+// Israel. The first yahrzeit follows the customs chosen in y.
+func (y UserYahrzeit) Avelut(burial time.Time) Avelut {
+	if !burial.IsZero() {
+		y.Burial = burial
+	}
+	var burialDate hdate.HDate
+	if !y.Burial.IsZero() {
+		burialDate = hdate.FromTime(y.Burial)
+	}
+	il := y.Location != nil && y.Location.CountryCode == "IL"
+	death := y.HDate()
+	a := GetAvelut(death, burialDate, il)
+	// the first yahrzeit on the anniversary of a burial in the following
+	// Hebrew year is in the second year after the death
+	for hyear := death.Year() + 1; hyear <= death.Year()+2; hyear++ {
+		if dates := y.Yahrzeits(hyear); len(dates) != 0 {
+			a.FirstYahrzeit = dates[0]
+			break
+		}
+	}
+	return a
+}
+
+// Events returns the timeline as calendar events for the deceased name,
+// e.g. "Shiva ends: Sarah".
+func (a Avelut) Events(name string) []event.CalEvent {
+	return []event.CalEvent{
+		event.UserEvent{Date: a.ShivaBegins, Desc: "Shiva begins: " + name},
+		event.UserEvent{Date: a.ShivaEnds, Desc: "Shiva ends: " + name},
+		event.UserEvent{Date: a.ShloshimEnds, Desc: "Shloshim ends: " + name},
+		event.UserEvent{Date: a.KaddishEnds, Desc: "Kaddish ends: " + name},
+		event.UserEvent{Date: a.TwelveMonthsEnd, Desc: "Twelve months of mourning end: " + name},
+		event.UserEvent{Date: a.FirstYahrzeit, Desc: "First Yahrzeit: " + name},
+	}
+}
+
+// festival is a run of consecutive days of Yom Tov and Chol HaMoed.
+type festival struct {
+	first, last int64 // R.D. of the first and last days
+	// R.D. of the first day after the festival counted toward mourning
+	countFrom int64
+	// number of days the festival counts toward shloshim
+	days int
+}
+
+// getFestivals returns the festivals of Hebrew years year and year+1,
+// which cover the thirty days following any burial in year.
+func getFestivals(year int, il bool) []festival {
+	flags := make(map[int64]event.HolidayFlags)
+	shminiAtzeret := make(map[int64]bool)
+	for _, y := range []int{year, year + 1} {
+		for _, ev := range GetHolidaysForYear(y, il) {
+			if (ev.Flags & (event.CHAG | event.CHOL_HAMOED)) != 0 {
+				abs := ev.Date.Abs()
+				flags[abs] |= ev.Flags
+				if ev.Desc == "Shmini Atzeret" {
+					shminiAtzeret[abs] = true
+				}
+			}
+		}
+	}
+	days := make([]int64, 0, len(flags))
+	for abs := range flags {
+		days = append(days, abs)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+	var fests []festival
+	for i, abs := range days {
+		if i == 0 || abs != days[i-1]+1 {
+			fests = append(fests, festival{first: abs, days: festivalDays})
+		}
+		f := &fests[len(fests)-1]
+		f.last = abs
+		// Shmini Atzeret is a festival in its own right
+		if shminiAtzeret[abs] {
+			f.days += festivalDays
+		}
+	}
+	for i := range fests {
+		f := &fests[i]
+		f.countFrom = f.last + 1
+		// the second day of Yom Tov observed in the Diaspora counts
+		if (flags[f.last] & event.CHUL_ONLY) != 0 {
+			f.countFrom = f.last
+		}
+	}
+	return fests
+}
+
+// addMonths returns the date n Hebrew months after hd, counting Adar I and
+// Adar II as separate months. If the resulting month is too short, the
+// last day of the month is used.
+func addMonths(hd hdate.HDate, n int) hdate.HDate {
+	year := hd.Year()
+	idx := monthIndex(hd.Month(), year) + n
+	for idx >= hdate.MonthsInYear(year) {
+		idx -= hdate.MonthsInYear(year)
+		year++
+	}
+	month := monthFromIndex(idx, year)
+	day := hd.Day()
+	if dim := hdate.DaysInMonth(month, year); day > dim {
+		day = dim
+	}
+	return hdate.New(year, month, day)
+}
+
+// monthIndex returns the position of month in year, counting from 0 for
+// Tishrei.
+func monthIndex(month hdate.HMonth, year int) int {
+	if month >= hdate.Tishrei {
+		return int(month - hdate.Tishrei)
+	}
+	return int(month) + hdate.MonthsInYear(year) - int(hdate.Tishrei)
+}
+
+// monthFromIndex is the inverse of monthIndex.
+func monthFromIndex(idx, year int) hdate.HMonth {
+	n := hdate.MonthsInYear(year)
+	if idx < n-6 {
+		return hdate.HMonth(idx) + hdate.Tishrei
+	}
+	return hdate.HMonth(idx - (n - int(hdate.Tishrei)))
+}
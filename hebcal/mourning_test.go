@@ -0,0 +1,108 @@
+package hebcal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+func TestGetAvelut(t *testing.T) {
+	assert := assert.New(t)
+	death := hdate.New(5783, hdate.Tevet, 10)
+	a := hebcal.GetAvelut(death, hdate.HDate{}, false)
+	assert.Equal(death, a.Burial)
+	assert.Equal(death, a.ShivaBegins)
+	assert.Equal(hdate.New(5783, hdate.Tevet, 16), a.ShivaEnds)
+	assert.False(a.ShivaCancelled)
+	assert.Equal(hdate.New(5783, hdate.Shvat, 10), a.ShloshimEnds)
+	assert.False(a.ShloshimCancelled)
+	assert.Equal(hdate.New(5784, hdate.Kislev, 9), a.KaddishEnds)
+	assert.Equal(hdate.New(5784, hdate.Tevet, 9), a.TwelveMonthsEnd)
+	assert.Equal(hdate.New(5784, hdate.Tevet, 10), a.FirstYahrzeit)
+}
+
+func TestGetAvelutLeapYear(t *testing.T) {
+	assert := assert.New(t)
+	// 5784 is a leap year: Adar I and Adar II are separate months
+	death := hdate.New(5783, hdate.Nisan, 10)
+	a := hebcal.GetAvelut(death, hdate.New(5783, hdate.Nisan, 11), false)
+	assert.Equal(hdate.New(5784, hdate.Adar1, 9), a.KaddishEnds)
+	assert.Equal(hdate.New(5784, hdate.Adar2, 10), a.TwelveMonthsEnd)
+}
+
+func TestGetAvelutPesachCancelsShiva(t *testing.T) {
+	assert := assert.New(t)
+	for _, il := range []bool{false, true} {
+		a := hebcal.GetAvelut(hdate.New(5783, hdate.Nisan, 10), hdate.HDate{}, il)
+		assert.True(a.ShivaCancelled)
+		assert.Equal(hdate.New(5783, hdate.Nisan, 14), a.ShivaEnds)
+		// 7 days of shiva, 7 of Pesach and 16 more after it
+		assert.Equal(hdate.New(5783, hdate.Iyyar, 7), a.ShloshimEnds)
+		assert.False(a.ShloshimCancelled)
+	}
+}
+
+func TestGetAvelutSukkot(t *testing.T) {
+	assert := assert.New(t)
+	a := hebcal.GetAvelut(hdate.New(5784, hdate.Tishrei, 12), hdate.HDate{}, true)
+	assert.True(a.ShivaCancelled)
+	assert.Equal(hdate.New(5784, hdate.Tishrei, 14), a.ShivaEnds)
+	// Sukkot and Shmini Atzeret count as 14 days, leaving 9
+	assert.Equal(hdate.New(5784, hdate.Cheshvan, 1), a.ShloshimEnds)
+}
+
+func TestGetAvelutYomKippurCancelsShloshim(t *testing.T) {
+	assert := assert.New(t)
+	a := hebcal.GetAvelut(hdate.New(5783, hdate.Elul, 28), hdate.HDate{}, false)
+	assert.True(a.ShivaCancelled)
+	assert.Equal(hdate.New(5783, hdate.Elul, 29), a.ShivaEnds)
+	assert.True(a.ShloshimCancelled)
+	assert.Equal(hdate.New(5784, hdate.Tishrei, 9), a.ShloshimEnds)
+	// shiva complete before Shavuot: Shavuot cancels shloshim
+	a = hebcal.GetAvelut(hdate.New(5783, hdate.Iyyar, 20), hdate.HDate{}, false)
+	assert.False(a.ShivaCancelled)
+	assert.True(a.ShloshimCancelled)
+	assert.Equal(hdate.New(5783, hdate.Sivan, 5), a.ShloshimEnds)
+}
+
+func TestGetAvelutBurialDuringFestival(t *testing.T) {
+	assert := assert.New(t)
+	burial := hdate.New(5783, hdate.Nisan, 17)
+	// the last day of Pesach in the Diaspora is the first day of shiva
+	a := hebcal.GetAvelut(burial, hdate.HDate{}, false)
+	assert.Equal(hdate.New(5783, hdate.Nisan, 22), a.ShivaBegins)
+	assert.Equal(hdate.New(5783, hdate.Nisan, 28), a.ShivaEnds)
+	assert.Equal(hdate.New(5783, hdate.Iyyar, 16), a.ShloshimEnds)
+	a = hebcal.GetAvelut(burial, hdate.HDate{}, true)
+	assert.Equal(hdate.New(5783, hdate.Nisan, 22), a.ShivaBegins)
+	a = hebcal.GetAvelut(hdate.New(5783, hdate.Sivan, 6), hdate.HDate{}, true)
+	assert.Equal(hdate.New(5783, hdate.Sivan, 7), a.ShivaBegins)
+	a = hebcal.GetAvelut(hdate.New(5783, hdate.Sivan, 6), hdate.HDate{}, false)
+	assert.Equal(hdate.New(5783, hdate.Sivan, 7), a.ShivaBegins)
+}
+
+func TestUserYahrzeitAvelut(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.LookupCity("Jerusalem")
+	jer, _ := time.LoadLocation("Asia/Jerusalem")
+	y := hebcal.UserYahrzeit{
+		Date:     time.Date(2023, time.April, 7, 12, 0, 0, 0, jer), // 16 Nisan 5783
+		Name:     "Sarah",
+		Location: loc,
+	}
+	a := y.Avelut(time.Time{})
+	assert.Equal(hdate.New(5783, hdate.Nisan, 16), a.Burial)
+	// Israel: the day after Pesach VII
+	assert.Equal(hdate.New(5783, hdate.Nisan, 22), a.ShivaBegins)
+	events := a.Events(y.Name)
+	assert.Equal(6, len(events))
+	assert.Equal(event.UserEvent{Date: a.ShivaEnds, Desc: "Shiva ends: Sarah"}, events[1])
+	assert.Equal("First Yahrzeit: Sarah", events[5].Render("en"))
+	assert.Equal(hdate.New(5784, hdate.Nisan, 16), events[5].GetDate())
+}
@@ -47,6 +47,82 @@ type UserEvent struct {
 type UserYahrzeit struct {
 	Date time.Time // Gregorian Date of death
 	Name string    // Name of deceased
+	// Hebrew name of deceased (optional), e.g. "שרה בת אברהם"
+	HebrewName string
+	// Died after sunset, on the following Hebrew date. Ignored if
+	// Location is set, unless the sun does not set there that day.
+	AfterSunset bool
+	// Location of death. If set, Date must include the time of death, which
+	// is compared with the time of sunset there on that day to determine
+	// the Hebrew date.
+	Location *zmanim.Location
+	// Adar of a leap year in which the yahrzeit is observed, when the
+	// death occurred in Adar of an ordinary year
+	Adar YahrzeitAdar
+	// Gregorian Date of burial, used by FirstYahrzeitOnBurial
+	Burial time.Time
+	// Observe the first yahrzeit on the anniversary of the burial, when
+	// burial took place three or more days after the death. Later
+	// yahrzeits are observed on the anniversary of the death.
+	FirstYahrzeitOnBurial bool
+}
+
+// YahrzeitAdar selects the Adar of a leap year in which a yahrzeit is
+// observed when the death occurred in Adar of an ordinary year. Customs
+// differ; a death in Adar I or Adar II of a leap year is always observed
+// in the same month.
+type YahrzeitAdar int
+
+const (
+	// Adar I, as in hdate.GetYahrzeit
+	YahrzeitAdarI YahrzeitAdar = iota
+	// Adar II
+	YahrzeitAdarII
+	// Both Adar I and Adar II
+	YahrzeitAdarBoth
+)
+
+// HDate returns the Hebrew date of death. Since the Hebrew day begins at
+// sunset, a death after sunset occurred on the following Hebrew date.
+func (y UserYahrzeit) HDate() hdate.HDate {
+	t := y.Date
+	afterSunset := y.AfterSunset
+	if y.Location != nil {
+		if tz, err := zmanim.LoadLocation(y.Location.TimeZoneId); err == nil {
+			t = t.In(tz)
+			zman := zmanim.New(y.Location, t)
+			// where the sun does not set, fall back to AfterSunset
+			if sunset := zman.Sunset(); !sunset.IsZero() {
+				afterSunset = !t.Before(sunset)
+			}
+		}
+	}
+	hd := hdate.FromTime(t)
+	if afterSunset {
+		hd = hd.Next()
+	}
+	return hd
+}
+
+// Mitzvah selects which Hebrew birthday of a UserBirthday, if any, is
+// marked as a bar or bat mitzvah.
+type Mitzvah int
+
+const (
+	// No bar or bat mitzvah
+	NoMitzvah Mitzvah = iota
+	// Bar mitzvah, on the 13th Hebrew birthday
+	BarMitzvah
+	// Bat mitzvah, on the 12th Hebrew birthday (see CalOptions.BatMitzvahAge)
+	BatMitzvah
+)
+
+// UserBirthday is used for generating Hebrew birthday reminder events.
+type UserBirthday struct {
+	Date        time.Time // Gregorian Date of birth
+	AfterSunset bool      // Born after sunset, on the following Hebrew date
+	Name        string    // Name of the person
+	Mitzvah     Mitzvah   // Mark the bar or bat mitzvah
 }
 
 // CalOptions are used by HebrewCalendar() to configure which events are returned
@@ -76,6 +152,13 @@ type CalOptions struct {
 	// the amount of light in the sky and are intentionally never affected by
 	// elevation. Defaults to false.
 	UseElevation bool
+	// PolarFallback selects how candle-lighting, havdalah and other zmanim
+	// are approximated at high latitudes on days when the sun does not set
+	// or does not reach the required depression (see zmanim.PolarFallback).
+	// By default such times are omitted.
+	PolarFallback zmanim.PolarFallback
+	// Latitude used by zmanim.PolarNearestLatitude (default 60).
+	PolarLatitude float64
 	/* minutes before sundown to light candles (default 18) */
 	CandleLightingMins int
 	// minutes after sundown for Havdalah (typical values are 42, 50, or 72).
@@ -122,6 +205,23 @@ type CalOptions struct {
 	Omer bool
 	/* include event announcing the molad */
 	Molad bool
+	// include the earliest and latest times for Kiddush Levana each month.
+	// By default Kiddush Levana begins 3 days after the molad and ends
+	// halfway between one molad and the next (see KiddushLevana7Days and
+	// KiddushLevana15Days for the other common opinions). Times are in the
+	// Location's time zone, or Jerusalem's if Location is nil.
+	KiddushLevana bool
+	// begin Kiddush Levana 7 days after the molad instead of 3
+	KiddushLevana7Days bool
+	// end Kiddush Levana 15 days after the molad instead of halfway
+	// between moladot
+	KiddushLevana15Days bool
+	// include the four tekufot (seasons) each year, according to Shmuel.
+	// Times are in the Location's time zone, or Jerusalem's if Location is
+	// nil.
+	Tekufot bool
+	// calculate the tekufot according to Rav Adda instead of Shmuel
+	TekufotRavAdda bool
 	/* print the Hebrew date for the entire date range */
 	AddHebrewDates bool
 	/* print the Hebrew date for dates with some events */
@@ -152,10 +252,19 @@ type CalOptions struct {
 	// Tefilah, sof zeman;  Chatzot hayom; Mincha Gedolah; Mincha Ketanah;
 	// Plag HaMincha; Tzait HaKochavim).
 	DailyZmanim bool
+	// Name of the zmanim.Profile listing the zmanim added by DailyZmanim,
+	// such as "chabad" or "sephardi-il" (default zmanim.DefaultProfile).
+	// See zmanim.Profiles.
+	ZmanimProfile string
 	// Add Yahrzeit reminders when the anniversary falls within the date range.
 	Yahrzeits []UserYahrzeit
 	// Add non-yahrtzeit Hebrew user event reminders when the anniversary falls within the date range.
 	UserEvents []UserEvent
+	// Add Hebrew birthday reminders, including bar and bat mitzvah, when the
+	// birthday falls within the date range.
+	Birthdays []UserBirthday
+	// Hebrew age of bat mitzvah for Birthdays (default 12).
+	BatMitzvahAge int
 	// Weekly abbreviated view. Omer, dafyomi, and non-date-specific zemanim are shown once a week,
 	// on the day which corresponds to the first day in the range.
 	WeeklyAbbreviated bool
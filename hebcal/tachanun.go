@@ -0,0 +1,192 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"time"
+
+	"github.com/hebcal/hdate"
+)
+
+// Custom selects the liturgical custom (minhag) of a congregation where
+// it affects Tachanun or Hallel.
+type Custom int
+
+const (
+	// Ashkenazi custom. Tachanun is said on Pesach Sheni and omitted on
+	// Yom HaAtzma'ut and Yom Yerushalayim, when Hallel is said.
+	Ashkenazi Custom = iota
+	// Sephardi custom. Tachanun is also omitted on Pesach Sheni.
+	Sephardi
+	// Chasidic custom. Tachanun is omitted on Pesach Sheni but said on
+	// Yom HaAtzma'ut and Yom Yerushalayim, when Hallel is not said.
+	Chasidic
+)
+
+// Tachanun reports whether Tachanun is said at Shacharit and at Mincha.
+type Tachanun struct {
+	Shacharit bool
+	Mincha    bool
+}
+
+// Hallel is the form of Hallel recited at Shacharit.
+type Hallel int
+
+const (
+	// Hallel is not said
+	NoHallel Hallel = iota
+	// Half Hallel, on Rosh Chodesh and the latter days of Pesach
+	HalfHallel
+	// Whole Hallel, on the festivals and Chanukah
+	WholeHallel
+)
+
+// GetTachanun returns whether Tachanun is said on the given Hebrew date,
+// for either the Diaspora (il=false) or Israel (il=true).
+//
+// Tachanun is never said on Shabbat, nor at Mincha on Friday. It is not
+// said at Mincha before a day on which it is omitted, except before Erev
+// Rosh Hashana, Erev Yom Kippur and Pesach Sheni.
+//
+// This follows @hebcal/core's tachanun(), which omits Tachanun on Rosh
+// Chodesh, all of Nisan, Lag BaOmer, Rosh Chodesh Sivan until 12 Sivan,
+// Tish'a B'Av, Tu B'Av, Erev Rosh Hashana and Rosh Hashana, Erev Yom
+// Kippur until the end of Tishrei, Chanukah, Tu BiShvat, Purim and Shushan
+// Purim, and Purim Katan. Days on which only some congregations omit
+// Tachanun depend on custom.
+func GetTachanun(hd hdate.HDate, il bool, custom Custom) Tachanun {
+	dow := hd.Weekday()
+	if dow == time.Saturday || !isTachanunDay(hd, il, custom) {
+		return Tachanun{}
+	}
+	result := Tachanun{Shacharit: true}
+	if dow != time.Friday {
+		next := hd.Next()
+		month, day := next.Month(), next.Day()
+		result.Mincha = isTachanunDay(next, il, custom) ||
+			(month == hdate.Elul && day == 29) ||
+			(month == hdate.Tishrei && day == 9) ||
+			(month == hdate.Iyyar && day == 14)
+	}
+	return result
+}
+
+// isTachanunDay reports whether Tachanun is said on the weekday hd,
+// ignoring the Mincha before the following day.
+func isTachanunDay(hd hdate.HDate, il bool, custom Custom) bool {
+	year := hd.Year()
+	month := hd.Month()
+	day := hd.Day()
+	abs := hd.Abs()
+	if day == 1 || day == 30 {
+		return false // Rosh Chodesh and Rosh Hashana
+	}
+	switch month {
+	case hdate.Tishrei:
+		if day == 2 || day >= 9 {
+			return false
+		}
+	case hdate.Nisan:
+		return false
+	case hdate.Iyyar:
+		if day == 18 {
+			return false // Lag BaOmer
+		}
+		if day == 14 && custom != Ashkenazi {
+			return false // Pesach Sheni
+		}
+	case hdate.Sivan:
+		if day <= 12 {
+			return false // Shavuot, Isru Chag and the days following
+		}
+	case hdate.Av:
+		if day == 15 {
+			return false // Tu B'Av
+		}
+		av9 := hdate.New(year, hdate.Av, 9)
+		if av9.Weekday() == time.Saturday {
+			av9 = av9.Next()
+		}
+		if abs == av9.Abs() {
+			return false
+		}
+	case hdate.Elul:
+		if day == 29 {
+			return false // Erev Rosh Hashana
+		}
+	case hdate.Shvat:
+		if day == 15 {
+			return false // Tu BiShvat
+		}
+	case hdate.Adar1, hdate.Adar2:
+		if day == 14 || day == 15 {
+			return false // Purim, Shushan Purim and Purim Katan
+		}
+	}
+	chanukah := hdate.New(year, hdate.Kislev, 25).Abs()
+	if abs >= chanukah && abs <= chanukah+7 {
+		return false
+	}
+	if custom != Chasidic {
+		for _, ev := range GetHolidaysOnDate(hd, il) {
+			if ev.Desc == "Yom HaAtzma'ut" || ev.Desc == "Yom Yerushalayim" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// GetHallel returns the form of Hallel said at Shacharit on the given
+// Hebrew date, for either the Diaspora (il=false) or Israel (il=true).
+// Hallel is not said on Rosh Hashana, Yom Kippur or Purim.
+//
+// Whole Hallel is said on Yom HaAtzma'ut and Yom Yerushalayim except
+// according to Chasidic custom.
+func GetHallel(hd hdate.HDate, il bool, custom Custom) Hallel {
+	year := hd.Year()
+	month := hd.Month()
+	day := hd.Day()
+	abs := hd.Abs()
+	yomTovSheni := 0
+	if !il {
+		yomTovSheni = 1
+	}
+	chanukah := hdate.New(year, hdate.Kislev, 25).Abs()
+	switch {
+	case abs >= chanukah && abs <= chanukah+7:
+		return WholeHallel
+	case month == hdate.Tishrei && day >= 15 && day <= 22+yomTovSheni:
+		return WholeHallel // Sukkot, Shmini Atzeret and Simchat Torah
+	case month == hdate.Nisan && day >= 15 && day <= 15+yomTovSheni:
+		return WholeHallel
+	case month == hdate.Sivan && day >= 6 && day <= 6+yomTovSheni:
+		return WholeHallel
+	case month == hdate.Nisan && day >= 15 && day <= 21+yomTovSheni:
+		return HalfHallel
+	case month != hdate.Tishrei && (day == 1 || day == 30):
+		return HalfHallel // Rosh Chodesh
+	}
+	if custom != Chasidic {
+		for _, ev := range GetHolidaysOnDate(hd, il) {
+			if ev.Desc == "Yom HaAtzma'ut" || ev.Desc == "Yom Yerushalayim" {
+				return WholeHallel
+			}
+		}
+	}
+	return NoHallel
+}
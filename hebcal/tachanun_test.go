@@ -0,0 +1,92 @@
+package hebcal_test
+
+import (
+	"testing"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/hebcal"
+)
+
+func TestGetTachanun(t *testing.T) {
+	assert := assert.New(t)
+	both := hebcal.Tachanun{Shacharit: true, Mincha: true}
+	shacharitOnly := hebcal.Tachanun{Shacharit: true}
+	none := hebcal.Tachanun{}
+	tests := []struct {
+		hd       hdate.HDate
+		custom   hebcal.Custom
+		expected hebcal.Tachanun
+	}{
+		{hdate.FromGregorian(2023, 1, 9), hebcal.Ashkenazi, both},           // Monday 16 Tevet
+		{hdate.FromGregorian(2023, 1, 13), hebcal.Ashkenazi, shacharitOnly}, // Friday
+		{hdate.FromGregorian(2023, 1, 14), hebcal.Ashkenazi, none},          // Shabbat
+		{hdate.FromGregorian(2023, 1, 20), hebcal.Ashkenazi, shacharitOnly}, // Friday 27 Tevet
+		{hdate.FromGregorian(2023, 1, 22), hebcal.Ashkenazi, shacharitOnly}, // Erev Rosh Chodesh
+		{hdate.FromGregorian(2023, 1, 23), hebcal.Ashkenazi, none},          // Rosh Chodesh Sh'vat
+		{hdate.New(5783, hdate.Elul, 28), hebcal.Ashkenazi, both},           // before Erev Rosh Hashana
+		{hdate.New(5783, hdate.Elul, 29), hebcal.Ashkenazi, none},
+		{hdate.New(5784, hdate.Tishrei, 8), hebcal.Ashkenazi, none},         // Shabbat
+		{hdate.New(5783, hdate.Tishrei, 8), hebcal.Ashkenazi, both},         // Monday before Erev Yom Kippur
+		{hdate.New(5783, hdate.Tishrei, 3), hebcal.Ashkenazi, both},         // Tzom Gedaliah
+		{hdate.New(5783, hdate.Iyyar, 13), hebcal.Sephardi, both},           // before Pesach Sheni
+		{hdate.New(5783, hdate.Iyyar, 14), hebcal.Ashkenazi, shacharitOnly}, // Pesach Sheni, Friday
+		{hdate.New(5784, hdate.Iyyar, 14), hebcal.Ashkenazi, both},          // Pesach Sheni, Wednesday
+		{hdate.New(5784, hdate.Iyyar, 14), hebcal.Sephardi, none},
+		{hdate.New(5783, hdate.Iyyar, 17), hebcal.Ashkenazi, shacharitOnly}, // before Lag BaOmer
+		{hdate.New(5784, hdate.Iyyar, 18), hebcal.Ashkenazi, none},
+		{hdate.New(5784, hdate.Sivan, 12), hebcal.Ashkenazi, none},
+		{hdate.New(5784, hdate.Sivan, 13), hebcal.Ashkenazi, both},
+		{hdate.New(5784, hdate.Kislev, 28), hebcal.Ashkenazi, none}, // Chanukah
+		{hdate.New(5784, hdate.Adar2, 14), hebcal.Ashkenazi, none},  // Purim
+		{hdate.New(5784, hdate.Adar1, 14), hebcal.Ashkenazi, none},  // Purim Katan
+		{hdate.New(5784, hdate.Av, 10), hebcal.Ashkenazi, both},
+		{hdate.New(5782, hdate.Av, 10), hebcal.Ashkenazi, none}, // Tish'a B'Av postponed from Shabbat
+	}
+	for _, test := range tests {
+		assert.Equal(test.expected, hebcal.GetTachanun(test.hd, false, test.custom), test.hd.String())
+	}
+}
+
+func TestGetTachanunModernHolidays(t *testing.T) {
+	assert := assert.New(t)
+	// Yom HaAtzma'ut 5783 was on Wednesday, 5 Iyyar
+	yomHaAtzmaut := hdate.New(5783, hdate.Iyyar, 5)
+	assert.Equal(hebcal.Tachanun{}, hebcal.GetTachanun(yomHaAtzmaut, true, hebcal.Ashkenazi))
+	assert.Equal(hebcal.Tachanun{Shacharit: true}, hebcal.GetTachanun(yomHaAtzmaut.Prev(), true, hebcal.Ashkenazi))
+	assert.Equal(hebcal.Tachanun{Shacharit: true, Mincha: true}, hebcal.GetTachanun(yomHaAtzmaut, true, hebcal.Chasidic))
+	assert.Equal(hebcal.WholeHallel, hebcal.GetHallel(yomHaAtzmaut, true, hebcal.Ashkenazi))
+	assert.Equal(hebcal.NoHallel, hebcal.GetHallel(yomHaAtzmaut, true, hebcal.Chasidic))
+}
+
+func TestGetHallel(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		hd       hdate.HDate
+		il       bool
+		expected hebcal.Hallel
+	}{
+		{hdate.New(5783, hdate.Tishrei, 1), false, hebcal.NoHallel},
+		{hdate.New(5783, hdate.Tishrei, 10), false, hebcal.NoHallel},
+		{hdate.New(5783, hdate.Tishrei, 15), false, hebcal.WholeHallel},
+		{hdate.New(5783, hdate.Tishrei, 21), false, hebcal.WholeHallel},
+		{hdate.New(5783, hdate.Tishrei, 23), false, hebcal.WholeHallel},
+		{hdate.New(5783, hdate.Tishrei, 23), true, hebcal.NoHallel},
+		{hdate.New(5783, hdate.Cheshvan, 1), false, hebcal.HalfHallel},
+		{hdate.New(5783, hdate.Kislev, 30), false, hebcal.WholeHallel}, // Rosh Chodesh Tevet is during Chanukah
+		{hdate.New(5784, hdate.Tevet, 3), false, hebcal.WholeHallel},
+		{hdate.New(5784, hdate.Tevet, 4), false, hebcal.NoHallel},
+		{hdate.New(5784, hdate.Adar2, 14), false, hebcal.NoHallel},
+		{hdate.New(5784, hdate.Nisan, 15), true, hebcal.WholeHallel},
+		{hdate.New(5784, hdate.Nisan, 16), true, hebcal.HalfHallel},
+		{hdate.New(5784, hdate.Nisan, 16), false, hebcal.WholeHallel},
+		{hdate.New(5784, hdate.Nisan, 22), false, hebcal.HalfHallel},
+		{hdate.New(5784, hdate.Nisan, 22), true, hebcal.NoHallel},
+		{hdate.New(5784, hdate.Sivan, 7), false, hebcal.WholeHallel},
+		{hdate.New(5784, hdate.Sivan, 7), true, hebcal.NoHallel},
+	}
+	for _, test := range tests {
+		assert.Equal(test.expected, hebcal.GetHallel(test.hd, test.il, hebcal.Ashkenazi), test.hd.String())
+	}
+}
@@ -0,0 +1,49 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/tekufot"
+)
+
+// makeTekufotEvents returns the "Tekufat Nisan", "Tekufat Tammuz", ...
+// events whose dates fall within Hebrew year hyear. Since Tekufat Tishrei
+// can fall at the end of Elul, the tekufot of hyear+1 are considered too.
+func makeTekufotEvents(hyear int, opts *CalOptions) []TimedEvent {
+	opinion := tekufot.Shmuel
+	if opts.TekufotRavAdda {
+		opinion = tekufot.RavAdda
+	}
+	tz := jerusalemTimeZone(opts)
+	events := make([]TimedEvent, 0, 4)
+	for _, year := range []int{hyear, hyear + 1} {
+		all, err := tekufot.ForYear(year, opinion)
+		if err != nil {
+			continue
+		}
+		for _, t := range all {
+			local := t.Time.In(tz)
+			hd := hdate.FromGregorian(local.Year(), local.Month(), local.Day())
+			if hd.Year() == hyear {
+				events = append(events, NewTimedEvent(hd, t.String(), event.TEKUFA, local, 0, nil, opts))
+			}
+		}
+	}
+	return events
+}
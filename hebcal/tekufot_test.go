@@ -0,0 +1,56 @@
+package hebcal_test
+
+import (
+	"testing"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+func TestTekufot(t *testing.T) {
+	assert := assert.New(t)
+	opts := &hebcal.CalOptions{
+		Year:         5783,
+		IsHebrewYear: true,
+		Tekufot:      true,
+		NoHolidays:   true,
+		Location:     zmanim.LookupCity("New York"),
+	}
+	events, err := hebcal.HebrewCalendar(opts)
+	assert.NoError(err)
+	actual := make([]string, 0, len(events))
+	for _, ev := range events {
+		actual = append(actual, hd2iso(ev.GetDate())+" "+ev.Render("en"))
+	}
+	assert.Equal([]string{
+		"2022-10-07 Tekufat Tishrei: 8:39",
+		"2023-01-06 Tekufat Tevet: 3:09",
+		"2023-04-07 Tekufat Nisan: 11:39",
+		"2023-07-08 Tekufat Tammuz: 7:09",
+	}, actual)
+	assert.Equal(event.TEKUFA, events[0].GetFlags())
+	assert.Equal([]string{"tekufa"}, events[0].GetCategories())
+}
+
+func TestTekufotRavAdda(t *testing.T) {
+	assert := assert.New(t)
+	// Rav Adda's Tekufat Tishrei 5769 fell on the evening of September 25,
+	// 2008 (25 Elul 5768), before Rosh Hashana
+	opts := &hebcal.CalOptions{
+		Start:          hdate.New(5768, hdate.Elul, 1),
+		End:            hdate.New(5769, hdate.Tishrei, 30),
+		Tekufot:        true,
+		TekufotRavAdda: true,
+		NoHolidays:     true,
+		Hour24:         true,
+	}
+	events, err := hebcal.HebrewCalendar(opts)
+	assert.NoError(err)
+	assert.Equal(1, len(events))
+	assert.Equal(hdate.New(5768, hdate.Elul, 25), events[0].GetDate())
+	assert.Equal("Tekufat Tishrei: 19:47", events[0].Render("en"))
+}
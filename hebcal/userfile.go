@@ -0,0 +1,275 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hdate"
+)
+
+// ParseError is returned by ReadYahrzeits, ReadUserEvents and their CSV
+// variants for a line that cannot be parsed.
+type ParseError struct {
+	Line int   // Line number, starting at 1
+	Err  error // The actual error
+}
+
+func (e *ParseError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ReadYahrzeits parses a classic hebcal yahrzeit file (-Y). Each line
+// holds the Gregorian date of death followed by the name of the deceased:
+//
+//	MM DD YYYY Name
+//
+// Blank lines and lines starting with '#' are ignored.
+func ReadYahrzeits(r io.Reader) ([]UserYahrzeit, error) {
+	var result []UserYahrzeit
+	err := scanLines(r, func(fields []string) error {
+		if len(fields) < 4 {
+			return errors.New("expected \"MM DD YYYY Name\"")
+		}
+		month, err := strconv.Atoi(fields[0])
+		if err != nil || month < 1 || month > 12 {
+			return fmt.Errorf("invalid month %q", fields[0])
+		}
+		day, dayErr := strconv.Atoi(fields[1])
+		year, err := strconv.Atoi(fields[2])
+		if err != nil || year == 0 {
+			return fmt.Errorf("invalid year %q", fields[2])
+		}
+		// time.Date normalizes out-of-range days, e.g. February 30
+		// to March 1 or 2
+		t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if dayErr != nil || t.Month() != time.Month(month) || t.Day() != day {
+			return fmt.Errorf("invalid day %q", fields[1])
+		}
+		result = append(result, UserYahrzeit{
+			Date: t,
+			Name: strings.Join(fields[3:], " "),
+		})
+		return nil
+	})
+	return result, err
+}
+
+// ReadUserEvents parses a classic hebcal input file (-I) of events that
+// recur every year on a Hebrew date. Each line holds a Hebrew month name,
+// a day and a description:
+//
+//	Month Day Description
+//
+// Blank lines and lines starting with '#' are ignored.
+func ReadUserEvents(r io.Reader) ([]UserEvent, error) {
+	var result []UserEvent
+	err := scanLines(r, func(fields []string) error {
+		if len(fields) < 3 {
+			return errors.New("expected \"Month Day Description\"")
+		}
+		ev, err := parseUserEvent(fields[0], fields[1], strings.Join(fields[2:], " "))
+		if err != nil {
+			return err
+		}
+		result = append(result, ev)
+		return nil
+	})
+	return result, err
+}
+
+// scanLines calls fn with the whitespace-separated fields of each line
+// of r, skipping blank lines and comments. Errors are returned as a
+// *ParseError.
+func scanLines(r io.Reader, fn func(fields []string) error) error {
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if err := fn(strings.Fields(line)); err != nil {
+			return &ParseError{Line: lineNo, Err: err}
+		}
+	}
+	return scanner.Err()
+}
+
+// ReadYahrzeitsCSV parses a CSV file of yahrzeits, such as one exported
+// from a spreadsheet. The first record is a header naming the columns,
+// which may appear in any order (case is ignored):
+//
+//   - name: name of the deceased (required)
+//   - hebrew name: Hebrew name of the deceased
+//   - date: Gregorian date of death, as YYYY-MM-DD or M/D/YYYY (required)
+//   - after sunset: true if the death occurred after sunset
+//   - burial: Gregorian date of burial
+//   - adar: "I", "II" or "both" (see YahrzeitAdar)
+//
+// Other columns are ignored. Lines starting with '#' are ignored.
+func ReadYahrzeitsCSV(r io.Reader) ([]UserYahrzeit, error) {
+	var result []UserYahrzeit
+	err := scanCSV(r, []string{"name", "date"}, func(rec csvRecord) error {
+		y := UserYahrzeit{Name: rec.get("name"), HebrewName: rec.get("hebrew name")}
+		var err error
+		if y.Date, err = parseCSVDate(rec.get("date")); err != nil {
+			return err
+		}
+		if s := rec.get("after sunset"); s != "" {
+			if y.AfterSunset, err = parseCSVBool(s); err != nil {
+				return err
+			}
+		}
+		if s := rec.get("burial"); s != "" {
+			if y.Burial, err = parseCSVDate(s); err != nil {
+				return err
+			}
+		}
+		switch s := strings.ToLower(rec.get("adar")); s {
+		case "", "i", "1":
+		case "ii", "2":
+			y.Adar = YahrzeitAdarII
+		case "both":
+			y.Adar = YahrzeitAdarBoth
+		default:
+			return fmt.Errorf("invalid adar %q", s)
+		}
+		result = append(result, y)
+		return nil
+	})
+	return result, err
+}
+
+// ReadUserEventsCSV parses a CSV file of events that recur every year on
+// a Hebrew date. The first record is a header naming the columns "month"
+// (a Hebrew month name), "day" and "description", in any order. Other
+// columns are ignored. Lines starting with '#' are ignored.
+func ReadUserEventsCSV(r io.Reader) ([]UserEvent, error) {
+	var result []UserEvent
+	err := scanCSV(r, []string{"month", "day", "description"}, func(rec csvRecord) error {
+		ev, err := parseUserEvent(rec.get("month"), rec.get("day"), rec.get("description"))
+		if err != nil {
+			return err
+		}
+		result = append(result, ev)
+		return nil
+	})
+	return result, err
+}
+
+func parseUserEvent(monthName, dayStr, desc string) (UserEvent, error) {
+	month, err := hdate.MonthFromName(monthName)
+	if err != nil {
+		return UserEvent{}, err
+	}
+	day, err := strconv.Atoi(dayStr)
+	if err != nil || day < 1 || day > 30 {
+		return UserEvent{}, fmt.Errorf("invalid day %q", dayStr)
+	}
+	return UserEvent{Month: month, Day: day, Desc: desc}, nil
+}
+
+// csvRecord is a CSV record with the column indexes of its header.
+type csvRecord struct {
+	fields  []string
+	columns map[string]int
+}
+
+// get returns the trimmed value of the named column, or "" if there is
+// no such column.
+func (rec csvRecord) get(name string) string {
+	if i, ok := rec.columns[name]; ok {
+		return strings.TrimSpace(rec.fields[i])
+	}
+	return ""
+}
+
+// scanCSV reads the header of r, which must include the columns required,
+// and calls fn with each record that follows. Errors are returned as a
+// *ParseError, or a *csv.ParseError for malformed CSV.
+func scanCSV(r io.Reader, required []string, fn func(rec csvRecord) error) error {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		name = strings.NewReplacer("_", " ", "-", " ").Replace(name)
+		columns[name] = i
+	}
+	headerLine, _ := reader.FieldPos(0)
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return &ParseError{Line: headerLine, Err: fmt.Errorf("missing column %q", name)}
+		}
+	}
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := fn(csvRecord{fields: fields, columns: columns}); err != nil {
+			line, _ := reader.FieldPos(0)
+			return &ParseError{Line: line, Err: err}
+		}
+	}
+}
+
+// parseCSVDate parses a Gregorian date as YYYY-MM-DD or M/D/YYYY.
+func parseCSVDate(s string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", "1/2/2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+// parseCSVBool parses true/false, yes/no or 1/0.
+func parseCSVBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "yes", "y":
+		return true, nil
+	case "no", "n":
+		return false, nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean %q", s)
+	}
+	return b, nil
+}
@@ -0,0 +1,92 @@
+package hebcal_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/hebcal"
+)
+
+func TestReadYahrzeits(t *testing.T) {
+	assert := assert.New(t)
+	input := "# comment\n\n10 3 1995 Grandpa Joe\n"
+	yahrzeits, err := hebcal.ReadYahrzeits(strings.NewReader(input))
+	assert.NoError(err)
+	assert.Equal(1, len(yahrzeits))
+	assert.Equal("Grandpa Joe", yahrzeits[0].Name)
+	assert.Equal(time.Date(1995, time.October, 3, 0, 0, 0, 0, time.UTC), yahrzeits[0].Date)
+	_, err = hebcal.ReadYahrzeits(strings.NewReader("10 3 1995 Grandpa Joe\n13 3 1995 Bad month\n"))
+	assert.EqualError(err, `line 2: invalid month "13"`)
+	var perr *hebcal.ParseError
+	assert.True(errors.As(err, &perr))
+	assert.Equal(2, perr.Line)
+	_, err = hebcal.ReadYahrzeits(strings.NewReader("02 30 2020 Name\n"))
+	assert.EqualError(err, `line 1: invalid day "30"`)
+	_, err = hebcal.ReadYahrzeits(strings.NewReader("02 0 2020 Name\n"))
+	assert.EqualError(err, `line 1: invalid day "0"`)
+	yahrzeits, err = hebcal.ReadYahrzeits(strings.NewReader("02 29 2020 Name\n"))
+	assert.NoError(err)
+	assert.Equal(time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC), yahrzeits[0].Date)
+}
+
+func TestReadUserEvents(t *testing.T) {
+	assert := assert.New(t)
+	userEvents, err := hebcal.ReadUserEvents(strings.NewReader("Nisan 20 Anniversary of aliyah\n"))
+	assert.NoError(err)
+	assert.Equal([]hebcal.UserEvent{
+		{Month: hdate.Nisan, Day: 20, Desc: "Anniversary of aliyah"},
+	}, userEvents)
+	_, err = hebcal.ReadUserEvents(strings.NewReader("Nisan\n"))
+	assert.EqualError(err, `line 1: expected "Month Day Description"`)
+	_, err = hebcal.ReadUserEvents(strings.NewReader("# comment\nNisan 31 Too late\n"))
+	assert.EqualError(err, `line 2: invalid day "31"`)
+}
+
+func TestReadYahrzeitsCSV(t *testing.T) {
+	assert := assert.New(t)
+	input := `Name,Hebrew_Name,Date,After Sunset,Burial,Adar
+# comment
+"Cohen, Sarah",שרה בת אברהם,1995-10-03,yes,,
+Grandpa Joe,,3/8/2023,,3/12/2023,both
+`
+	yahrzeits, err := hebcal.ReadYahrzeitsCSV(strings.NewReader(input))
+	assert.NoError(err)
+	assert.Equal([]hebcal.UserYahrzeit{
+		{
+			Date:        time.Date(1995, time.October, 3, 0, 0, 0, 0, time.UTC),
+			Name:        "Cohen, Sarah",
+			HebrewName:  "שרה בת אברהם",
+			AfterSunset: true,
+		},
+		{
+			Date:   time.Date(2023, time.March, 8, 0, 0, 0, 0, time.UTC),
+			Name:   "Grandpa Joe",
+			Burial: time.Date(2023, time.March, 12, 0, 0, 0, 0, time.UTC),
+			Adar:   hebcal.YahrzeitAdarBoth,
+		},
+	}, yahrzeits)
+	_, err = hebcal.ReadYahrzeitsCSV(strings.NewReader("name,died\nJoe,1995-10-03\n"))
+	assert.EqualError(err, `line 1: missing column "date"`)
+	_, err = hebcal.ReadYahrzeitsCSV(strings.NewReader("name,date\nJoe,1995-10-03\nMoe,October 3\n"))
+	assert.EqualError(err, `line 3: invalid date "October 3"`)
+	_, err = hebcal.ReadYahrzeitsCSV(strings.NewReader("name,date\nJoe,1995-10-03,extra\n"))
+	assert.Error(err)
+}
+
+func TestReadUserEventsCSV(t *testing.T) {
+	assert := assert.New(t)
+	input := "month,day,description\nNisan,20,Anniversary of aliyah\nAdar II,7,\"Birthday, Moshe\"\n"
+	userEvents, err := hebcal.ReadUserEventsCSV(strings.NewReader(input))
+	assert.NoError(err)
+	assert.Equal([]hebcal.UserEvent{
+		{Month: hdate.Nisan, Day: 20, Desc: "Anniversary of aliyah"},
+		{Month: hdate.Adar2, Day: 7, Desc: "Birthday, Moshe"},
+	}, userEvents)
+	_, err = hebcal.ReadUserEventsCSV(strings.NewReader("month,day,description\nNisan,x,Bad\n"))
+	assert.EqualError(err, `line 2: invalid day "x"`)
+}
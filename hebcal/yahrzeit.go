@@ -0,0 +1,69 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"github.com/hebcal/hdate"
+)
+
+// minBurialDelay is the number of days between death and burial after
+// which the first yahrzeit may be observed on the anniversary of the burial.
+const minBurialDelay = 3
+
+// Yahrzeits returns the dates in Hebrew year hyear on which the yahrzeit of
+// y is observed, according to y.Adar and y.FirstYahrzeitOnBurial. It
+// returns two dates when the yahrzeit is observed in both Adar I and Adar
+// II, and none if hyear precedes the first yahrzeit.
+func (y UserYahrzeit) Yahrzeits(hyear int) []hdate.HDate {
+	death := y.HDate()
+	var dates []hdate.HDate
+	if y.FirstYahrzeitOnBurial && !y.Burial.IsZero() {
+		burial := hdate.FromTime(y.Burial)
+		if burial.Abs()-death.Abs() >= minBurialDelay {
+			firstYear := burial.Year() + 1
+			if hyear < firstYear {
+				return nil
+			}
+			if hyear == firstYear {
+				dates = y.anniversaries(hyear, burial)
+				if burial.Year() == death.Year() {
+					return dates
+				}
+			}
+		}
+	}
+	return append(dates, y.anniversaries(hyear, death)...)
+}
+
+// anniversaries returns the yahrzeit in hyear of a death on orig, in Adar
+// I, Adar II or both according to y.Adar.
+func (y UserYahrzeit) anniversaries(hyear int, orig hdate.HDate) []hdate.HDate {
+	hd, err := hdate.GetYahrzeit(hyear, orig)
+	if err != nil {
+		return nil
+	}
+	if orig.Month() == hdate.Adar1 && !hdate.IsLeapYear(orig.Year()) && hdate.IsLeapYear(hyear) {
+		adar2 := hdate.New(hyear, hdate.Adar2, hd.Day())
+		switch y.Adar {
+		case YahrzeitAdarII:
+			return []hdate.HDate{adar2}
+		case YahrzeitAdarBoth:
+			return []hdate.HDate{hd, adar2}
+		}
+	}
+	return []hdate.HDate{hd}
+}
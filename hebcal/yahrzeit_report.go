@@ -0,0 +1,191 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/sedra"
+)
+
+// YahrzeitReportOptions configure GetYahrzeitReport.
+type YahrzeitReportOptions struct {
+	Start hdate.HDate // First date of the report
+	End   hdate.HDate // Last date of the report
+	// Israel holiday and sedra schedule
+	IL bool
+	// Names are read on the Shabbat on or before the yahrzeit. Set
+	// ShabbatAfter to read them on the Shabbat on or after it instead.
+	ShabbatAfter bool
+	// Locale used for the parsha and Hebrew dates (default "en"). Use "he"
+	// to render them in Hebrew.
+	Locale string
+	// Render the Hebrew name of the deceased, when known, alongside the name
+	HebrewNames bool
+}
+
+// YahrzeitReportEntry is a single yahrzeit in a YahrzeitReport.
+type YahrzeitReportEntry struct {
+	Name       string      // Name of deceased
+	HebrewName string      // Hebrew name of deceased, if known
+	Date       hdate.HDate // Date on which the yahrzeit is observed
+	Years      int         // Number of Hebrew years since the death
+}
+
+// YahrzeitReportWeek is the group of yahrzeits whose names are read on the
+// same Shabbat.
+type YahrzeitReportWeek struct {
+	Shabbat hdate.HDate // Shabbat on which the names are read
+	// Rendered weekly Torah portion, e.g. "Parashat Noach", or the holiday
+	// when no parsha is read, e.g. "Pesach III (CH''M)"
+	Parsha  string
+	Entries []YahrzeitReportEntry // Yahrzeits sorted by date and name
+}
+
+// YahrzeitReport is a list of upcoming yahrzeits for a synagogue board or
+// plaque, grouped by week.
+type YahrzeitReport struct {
+	Weeks []YahrzeitReportWeek
+	opts  YahrzeitReportOptions
+}
+
+// GetYahrzeitReport returns the yahrzeits observed between opts.Start and
+// opts.End, inclusive, grouped by the Shabbat on which each name is read.
+// Yahrzeits are observed according to the customs of each UserYahrzeit
+// (see UserYahrzeit.Yahrzeits).
+func GetYahrzeitReport(yahrzeits []UserYahrzeit, opts *YahrzeitReportOptions) (YahrzeitReport, error) {
+	if opts == nil || opts.Start == (hdate.HDate{}) || opts.End == (hdate.HDate{}) {
+		return YahrzeitReport{}, errors.New("report requires both Start and End")
+	}
+	start, end := opts.Start.Abs(), opts.End.Abs()
+	if start > end {
+		return YahrzeitReport{}, fmt.Errorf("start %s is after end %s", opts.Start, opts.End)
+	}
+	var entries []YahrzeitReportEntry
+	for hyear := opts.Start.Year(); hyear <= opts.End.Year(); hyear++ {
+		for _, y := range yahrzeits {
+			death := y.HDate()
+			for _, hd := range y.Yahrzeits(hyear) {
+				if abs := hd.Abs(); abs >= start && abs <= end {
+					entries = append(entries, YahrzeitReportEntry{
+						Name:       y.Name,
+						HebrewName: y.HebrewName,
+						Date:       hd,
+						Years:      hyear - death.Year(),
+					})
+				}
+			}
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].Date.Abs(), entries[j].Date.Abs()
+		if a != b {
+			return a < b
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	report := YahrzeitReport{opts: *opts}
+	sedrot := make(map[int]sedra.Sedra)
+	for _, entry := range entries {
+		var shabbat hdate.HDate
+		if opts.ShabbatAfter {
+			shabbat = entry.Date.OnOrAfter(time.Saturday)
+		} else {
+			shabbat = entry.Date.OnOrBefore(time.Saturday)
+		}
+		n := len(report.Weeks)
+		if n == 0 || report.Weeks[n-1].Shabbat != shabbat {
+			report.Weeks = append(report.Weeks, YahrzeitReportWeek{
+				Shabbat: shabbat,
+				Parsha:  shabbatParsha(shabbat, opts, sedrot),
+			})
+			n++
+		}
+		report.Weeks[n-1].Entries = append(report.Weeks[n-1].Entries, entry)
+	}
+	return report, nil
+}
+
+// shabbatParsha renders the parsha read on shabbat, or the holiday on
+// which no parsha is read.
+func shabbatParsha(shabbat hdate.HDate, opts *YahrzeitReportOptions, sedrot map[int]sedra.Sedra) string {
+	year := shabbat.Year()
+	s, ok := sedrot[year]
+	if !ok {
+		s = sedra.New(year, opts.IL)
+		sedrot[year] = s
+	}
+	locale := opts.locale()
+	parsha := s.LookupByRD(shabbat.Abs())
+	if !parsha.Chag {
+		return event.NewParshaEvent(shabbat, parsha, opts.IL).Render(locale)
+	}
+	for _, ev := range GetHolidaysOnDate(shabbat, opts.IL) {
+		if (ev.Flags & (event.CHAG | event.CHOL_HAMOED)) != 0 {
+			return ev.Render(locale)
+		}
+	}
+	return ""
+}
+
+func (opts *YahrzeitReportOptions) locale() string {
+	if opts.Locale == "" {
+		return "en"
+	}
+	return opts.Locale
+}
+
+// WriteText writes the report to w as plain text, one line per Shabbat
+// followed by an indented line per yahrzeit with its Gregorian and Hebrew
+// dates:
+//
+//	Parashat Lech-Lecha - Sat 28 Oct 2023 (13th of Cheshvan, 5784)
+//	    Sun 29 Oct 2023  14th of Cheshvan, 5784  Grandpa Joe (10)
+func (r YahrzeitReport) WriteText(w io.Writer) error {
+	locale := r.opts.locale()
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, week := range r.Weeks {
+		shabbatHd := event.NewHebrewDateEvent(week.Shabbat).Render(locale)
+		if _, err := fmt.Fprintf(tw, "%s - %s (%s)\n", week.Parsha,
+			week.Shabbat.Gregorian().Format(reportDate), shabbatHd); err != nil {
+			return err
+		}
+		for _, entry := range week.Entries {
+			name := entry.Name
+			if r.opts.HebrewNames && entry.HebrewName != "" {
+				name += " / " + entry.HebrewName
+			}
+			if _, err := fmt.Fprintf(tw, "    %s\t%s\t%s (%d)\n",
+				entry.Date.Gregorian().Format(reportDate),
+				event.NewHebrewDateEvent(entry.Date).Render(locale),
+				name, entry.Years); err != nil {
+				return err
+			}
+		}
+	}
+	return tw.Flush()
+}
+
+// reportDate is the Gregorian date layout of the text report.
+const reportDate = "Mon 2 Jan 2006"
@@ -0,0 +1,91 @@
+package hebcal_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/hebcal"
+)
+
+var reportYahrzeits = []hebcal.UserYahrzeit{
+	{Date: time.Date(2001, time.April, 10, 0, 0, 0, 0, time.UTC), Name: "Uncle Moe"},
+	{Date: time.Date(2013, time.October, 18, 0, 0, 0, 0, time.UTC), Name: "Grandpa Joe", HebrewName: "יוסף בן אברהם"},
+	{Date: time.Date(2000, time.October, 24, 0, 0, 0, 0, time.UTC), Name: "Aunt Sarah"},
+	{Date: time.Date(2010, time.October, 20, 0, 0, 0, 0, time.UTC), Name: "Aunt Rivka"},
+}
+
+func TestGetYahrzeitReport(t *testing.T) {
+	assert := assert.New(t)
+	report, err := hebcal.GetYahrzeitReport(reportYahrzeits, &hebcal.YahrzeitReportOptions{
+		Start: hdate.New(5784, hdate.Cheshvan, 1),
+		End:   hdate.New(5784, hdate.Nisan, 30),
+	})
+	assert.NoError(err)
+	assert.Equal(3, len(report.Weeks))
+	// Friday's yahrzeit is read on the Shabbat before it
+	week := report.Weeks[0]
+	assert.Equal(hdate.New(5784, hdate.Cheshvan, 6), week.Shabbat)
+	assert.Equal("Parashat Noach", week.Parsha)
+	assert.Equal([]hebcal.YahrzeitReportEntry{
+		{Name: "Aunt Rivka", Date: hdate.New(5784, hdate.Cheshvan, 12), Years: 13},
+	}, week.Entries)
+	week = report.Weeks[1]
+	assert.Equal(hdate.New(5784, hdate.Cheshvan, 13), week.Shabbat)
+	assert.Equal("Parashat Lech-Lecha", week.Parsha)
+	assert.Equal([]hebcal.YahrzeitReportEntry{
+		{Name: "Grandpa Joe", HebrewName: "יוסף בן אברהם", Date: hdate.New(5784, hdate.Cheshvan, 14), Years: 10},
+	}, week.Entries)
+	week = report.Weeks[2]
+	assert.Equal(hdate.New(5784, hdate.Nisan, 12), week.Shabbat)
+	assert.Equal("Parashat Metzora", week.Parsha)
+	assert.Equal("Uncle Moe", week.Entries[0].Name)
+}
+
+func TestGetYahrzeitReportShabbatAfter(t *testing.T) {
+	assert := assert.New(t)
+	report, err := hebcal.GetYahrzeitReport(reportYahrzeits, &hebcal.YahrzeitReportOptions{
+		Start:        hdate.New(5784, hdate.Nisan, 1),
+		End:          hdate.New(5784, hdate.Nisan, 30),
+		ShabbatAfter: true,
+	})
+	assert.NoError(err)
+	assert.Equal(1, len(report.Weeks))
+	// no parsha is read on Shabbat Chol HaMoed
+	assert.Equal(hdate.New(5784, hdate.Nisan, 19), report.Weeks[0].Shabbat)
+	assert.Equal("Pesach V (CH''M)", report.Weeks[0].Parsha)
+}
+
+func TestGetYahrzeitReportErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := hebcal.GetYahrzeitReport(reportYahrzeits, &hebcal.YahrzeitReportOptions{
+		Start: hdate.New(5784, hdate.Nisan, 1),
+	})
+	assert.Error(err)
+	_, err = hebcal.GetYahrzeitReport(reportYahrzeits, &hebcal.YahrzeitReportOptions{
+		Start: hdate.New(5784, hdate.Nisan, 1),
+		End:   hdate.New(5784, hdate.Adar2, 1),
+	})
+	assert.Error(err)
+}
+
+func TestYahrzeitReportWriteText(t *testing.T) {
+	assert := assert.New(t)
+	report, err := hebcal.GetYahrzeitReport(reportYahrzeits, &hebcal.YahrzeitReportOptions{
+		Start:       hdate.New(5784, hdate.Cheshvan, 1),
+		End:         hdate.New(5784, hdate.Cheshvan, 29),
+		HebrewNames: true,
+	})
+	assert.NoError(err)
+	var sb strings.Builder
+	assert.NoError(report.WriteText(&sb))
+	expected := `Parashat Noach - Sat 21 Oct 2023 (6th of Cheshvan, 5784)
+    Fri 27 Oct 2023  12th of Cheshvan, 5784  Aunt Rivka (13)
+Parashat Lech-Lecha - Sat 28 Oct 2023 (13th of Cheshvan, 5784)
+    Sun 29 Oct 2023  14th of Cheshvan, 5784  Grandpa Joe / יוסף בן אברהם (10)
+`
+	assert.Equal(expected, sb.String())
+}
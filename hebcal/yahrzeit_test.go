@@ -0,0 +1,78 @@
+package hebcal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/hebcal"
+)
+
+func TestYahrzeitsAdar(t *testing.T) {
+	assert := assert.New(t)
+	// 15 Adar 5783, an ordinary year
+	y := hebcal.UserYahrzeit{Date: time.Date(2023, time.March, 8, 0, 0, 0, 0, time.UTC)}
+	adar1 := hdate.New(5784, hdate.Adar1, 15)
+	adar2 := hdate.New(5784, hdate.Adar2, 15)
+	assert.Equal([]hdate.HDate{adar1}, y.Yahrzeits(5784))
+	y.Adar = hebcal.YahrzeitAdarII
+	assert.Equal([]hdate.HDate{adar2}, y.Yahrzeits(5784))
+	y.Adar = hebcal.YahrzeitAdarBoth
+	assert.Equal([]hdate.HDate{adar1, adar2}, y.Yahrzeits(5784))
+	// in an ordinary year there is only one Adar
+	assert.Equal([]hdate.HDate{hdate.New(5785, hdate.Adar1, 15)}, y.Yahrzeits(5785))
+	assert.Nil(y.Yahrzeits(5783))
+	// a death in Adar I of a leap year is always observed in Adar I
+	y.Date = time.Date(2024, time.February, 24, 0, 0, 0, 0, time.UTC)
+	assert.Equal([]hdate.HDate{hdate.New(5787, hdate.Adar1, 15)}, y.Yahrzeits(5787))
+}
+
+func TestYahrzeitsFirstYahrzeitOnBurial(t *testing.T) {
+	assert := assert.New(t)
+	y := hebcal.UserYahrzeit{
+		Date:                  time.Date(2022, time.December, 25, 0, 0, 0, 0, time.UTC), // 1 Tevet 5783
+		Burial:                time.Date(2022, time.December, 29, 0, 0, 0, 0, time.UTC), // 5 Tevet 5783
+		FirstYahrzeitOnBurial: true,
+	}
+	assert.Equal([]hdate.HDate{hdate.New(5784, hdate.Tevet, 5)}, y.Yahrzeits(5784))
+	assert.Equal([]hdate.HDate{hdate.New(5785, hdate.Tevet, 1)}, y.Yahrzeits(5785))
+	// burial less than three days after the death
+	y.Burial = time.Date(2022, time.December, 27, 0, 0, 0, 0, time.UTC)
+	assert.Equal([]hdate.HDate{hdate.New(5784, hdate.Tevet, 1)}, y.Yahrzeits(5784))
+	// burial in the following Hebrew year
+	y.Date = time.Date(2023, time.September, 15, 0, 0, 0, 0, time.UTC)   // 29 Elul 5783
+	y.Burial = time.Date(2023, time.September, 19, 0, 0, 0, 0, time.UTC) // 4 Tishrei 5784
+	assert.Nil(y.Yahrzeits(5784))
+	assert.Equal([]hdate.HDate{
+		hdate.New(5785, hdate.Tishrei, 4),
+		hdate.New(5785, hdate.Elul, 29),
+	}, y.Yahrzeits(5785))
+	assert.Equal(hdate.New(5785, hdate.Tishrei, 4), y.Avelut(time.Time{}).FirstYahrzeit)
+}
+
+func TestHebrewCalendarYahrzeitAdarBoth(t *testing.T) {
+	opts := &hebcal.CalOptions{
+		Start:      hdate.New(5784, hdate.Adar1, 1),
+		End:        hdate.New(5784, hdate.Adar2, 29),
+		NoHolidays: true,
+		Yahrzeits: []hebcal.UserYahrzeit{
+			{
+				Date: time.Date(2023, time.March, 8, 0, 0, 0, 0, time.UTC),
+				Name: "Both Adars",
+				Adar: hebcal.YahrzeitAdarBoth,
+			},
+			{
+				Date: time.Date(2023, time.March, 9, 0, 0, 0, 0, time.UTC),
+				Name: "Adar II",
+				Adar: hebcal.YahrzeitAdarII,
+			},
+		},
+	}
+	checkEvents(t, "en", opts, []string{
+		"2024-02-24 Both Adars",
+		"2024-03-25 Both Adars",
+		"2024-03-26 Adar II",
+	})
+}
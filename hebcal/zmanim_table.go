@@ -0,0 +1,237 @@
+package hebcal
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/hebcal/locales"
+)
+
+// ZmanimTableRow is one day of a ZmanimTable.
+type ZmanimTableRow struct {
+	Date hdate.HDate
+	// One time per column of the table, or the zero time if the zman does
+	// not occur on this day
+	Times []time.Time
+}
+
+// ZmanimTable is a chart of zmanim with one row per day and one column per
+// zman, such as the monthly chart posted on a synagogue wall.
+type ZmanimTable struct {
+	Columns []zmanim.Zman
+	Rows    []ZmanimTableRow
+	opts    *CalOptions
+}
+
+// GetZmanimTable returns the zmanim with the given registry keys (see
+// zmanim.LookupZman) for every day in the date range and location of opts
+// (opts.Location is required). The range is chosen as for HebrewCalendar:
+// a Gregorian month or year, a Hebrew year, or Start and End, e.g. the
+// first and last days of a Hebrew month. If keys is empty, the columns are
+// the zmanim of opts.ZmanimProfile.
+//
+// The elevation, polar fallback and Hour24 options of opts are honored;
+// other event types are ignored.
+func GetZmanimTable(opts *CalOptions, keys ...string) (ZmanimTable, error) {
+	if opts == nil || opts.Location == nil {
+		return ZmanimTable{}, errors.New("zmanim table requires a Location")
+	}
+	var columns []zmanim.Zman
+	if len(keys) == 0 {
+		name := opts.ZmanimProfile
+		if name == "" {
+			name = zmanim.DefaultProfile
+		}
+		profile, ok := zmanim.LookupProfile(name)
+		if !ok {
+			return ZmanimTable{}, fmt.Errorf("unknown zmanim profile %q", name)
+		}
+		var err error
+		if columns, err = profile.Zmanim(); err != nil {
+			return ZmanimTable{}, err
+		}
+	} else {
+		for _, key := range keys {
+			zm, ok := zmanim.LookupZman(key)
+			if !ok {
+				return ZmanimTable{}, fmt.Errorf("unknown zman %q", key)
+			}
+			columns = append(columns, zm)
+		}
+	}
+	tableOpts := *opts
+	startAbs, endAbs, err := getStartAndEnd(&tableOpts)
+	if err != nil {
+		return ZmanimTable{}, err
+	}
+	table := ZmanimTable{
+		Columns: columns,
+		Rows:    make([]ZmanimTableRow, 0, endAbs-startAbs+1),
+		opts:    &tableOpts,
+	}
+	for abs := startAbs; abs <= endAbs; abs++ {
+		hd := hdate.FromRD(abs)
+		z := newZmanim(hd, &tableOpts)
+		row := ZmanimTableRow{Date: hd, Times: make([]time.Time, len(columns))}
+		for i, zm := range columns {
+			row.Times[i] = zm.Calc(&z)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table, nil
+}
+
+// zmanTitle returns the label of zm in locale: the Hebrew label for "he",
+// otherwise the English label, translated when locale has a translation.
+func zmanTitle(zm zmanim.Zman, locale string) string {
+	if locale == "he" {
+		return zm.Hebrew
+	}
+	title, _ := locales.LookupTranslation(zm.English, locale)
+	return title
+}
+
+// formatCell formats tm with formatTime, or returns "-" for the zero time.
+func (t ZmanimTable) formatCell(tm time.Time) string {
+	if tm.IsZero() {
+		return "-"
+	}
+	return formatTime(tm, t.opts)
+}
+
+// WriteText writes the table to w as plain text, with a header line and a
+// line per day giving the Gregorian and Hebrew dates and the zmanim:
+//
+//	Date             Hebrew Date             Sunrise  Sunset
+//	Fri 1 Mar 2024   21st of Adar I, 5784    6:11     5:35
+func (t ZmanimTable) WriteText(w io.Writer, locale string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := dateHeadings(locale)
+	for _, zm := range t.Columns {
+		header = append(header, zmanTitle(zm, locale))
+	}
+	if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		fields := []string{
+			row.Date.Gregorian().Format(reportDate),
+			event.NewHebrewDateEvent(row.Date).Render(locale),
+		}
+		for _, tm := range row.Times {
+			fields = append(fields, t.formatCell(tm))
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(fields, "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteHTML writes the table to w as an HTML <table> element with the
+// class "zmanim", suitable for printing. Hebrew tables are marked
+// right-to-left.
+func (t ZmanimTable) WriteHTML(w io.Writer, locale string) error {
+	var sb strings.Builder
+	sb.WriteString(`<table class="zmanim"`)
+	if locale == "he" {
+		sb.WriteString(` dir="rtl"`)
+	}
+	sb.WriteString(">\n<thead>\n<tr>")
+	headerCell := func(s string) {
+		sb.WriteString("<th>" + html.EscapeString(s) + "</th>")
+	}
+	for _, heading := range dateHeadings(locale) {
+		headerCell(heading)
+	}
+	for _, zm := range t.Columns {
+		headerCell(zmanTitle(zm, locale))
+	}
+	sb.WriteString("</tr>\n</thead>\n<tbody>\n")
+	for _, row := range t.Rows {
+		sb.WriteString("<tr>")
+		greg := row.Date.Gregorian()
+		sb.WriteString(`<td><time datetime="` + greg.Format("2006-01-02") + `">` +
+			html.EscapeString(greg.Format(reportDate)) + "</time></td>")
+		sb.WriteString("<td>" + html.EscapeString(event.NewHebrewDateEvent(row.Date).Render(locale)) + "</td>")
+		for _, tm := range row.Times {
+			if tm.IsZero() {
+				sb.WriteString("<td>-</td>")
+			} else {
+				sb.WriteString(`<td><time datetime="` + tm.Format(time.RFC3339) + `">` +
+					html.EscapeString(t.formatCell(tm)) + "</time></td>")
+			}
+		}
+		sb.WriteString("</tr>\n")
+	}
+	sb.WriteString("</tbody>\n</table>\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// WriteCSV writes the table to w as CSV. The header names the columns by
+// their registry keys, after "date" and "hebrewDate"; dates are YYYY-MM-DD
+// and times are HH:MM:SS in 24-hour format, empty when the zman does not
+// occur.
+func (t ZmanimTable) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"date", "hebrewDate"}
+	for _, zm := range t.Columns {
+		header = append(header, zm.Key)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		record := []string{
+			row.Date.Gregorian().Format("2006-01-02"),
+			event.NewHebrewDateEvent(row.Date).Render("en"),
+		}
+		for _, tm := range row.Times {
+			s := ""
+			if !tm.IsZero() {
+				s = tm.Format("15:04:05")
+			}
+			record = append(record, s)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// dateHeadings returns the headings of the Gregorian and Hebrew date
+// columns.
+func dateHeadings(locale string) []string {
+	if locale == "he" {
+		return []string{"תאריך", "תאריך עברי"}
+	}
+	return []string{"Date", "Hebrew Date"}
+}
@@ -0,0 +1,90 @@
+package hebcal_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+func TestGetZmanimTableGregorianMonth(t *testing.T) {
+	assert := assert.New(t)
+	table, err := hebcal.GetZmanimTable(&hebcal.CalOptions{
+		Year:     2024,
+		Month:    time.March,
+		Location: zmanim.LookupCity("Jerusalem"),
+	}, "sunrise", "sunset", "tzeit7083")
+	assert.NoError(err)
+	assert.Equal(31, len(table.Rows))
+	assert.Equal(3, len(table.Columns))
+	assert.Equal("tzeit7083", table.Columns[2].Key)
+	first := table.Rows[0]
+	assert.Equal(hdate.New(5784, hdate.Adar1, 21), first.Date)
+	z := zmanim.New(zmanim.LookupCity("Jerusalem"), time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(z.Sunrise(), first.Times[0])
+	assert.Equal(z.Sunset(), first.Times[1])
+	assert.Equal(z.Tzeit(zmanim.Tzeit3MediumStars), first.Times[2])
+}
+
+func TestGetZmanimTableHebrewMonth(t *testing.T) {
+	assert := assert.New(t)
+	table, err := hebcal.GetZmanimTable(&hebcal.CalOptions{
+		Start:         hdate.New(5784, hdate.Adar2, 1),
+		End:           hdate.New(5784, hdate.Adar2, 29),
+		Location:      zmanim.LookupCity("Chicago"),
+		ZmanimProfile: "chabad",
+	})
+	assert.NoError(err)
+	assert.Equal(29, len(table.Rows))
+	assert.Equal("alosBaalHatanya", table.Columns[0].Key)
+	assert.Equal(11, len(table.Rows[28].Times))
+}
+
+func TestGetZmanimTableErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := hebcal.GetZmanimTable(&hebcal.CalOptions{Year: 2024})
+	assert.EqualError(err, "zmanim table requires a Location")
+	loc := zmanim.LookupCity("Chicago")
+	_, err = hebcal.GetZmanimTable(&hebcal.CalOptions{Year: 2024, Location: loc}, "sunrise", "noSuchZman")
+	assert.EqualError(err, `unknown zman "noSuchZman"`)
+	_, err = hebcal.GetZmanimTable(&hebcal.CalOptions{Year: 2024, Location: loc, ZmanimProfile: "nusach-mars"})
+	assert.EqualError(err, `unknown zmanim profile "nusach-mars"`)
+}
+
+func TestZmanimTableWriters(t *testing.T) {
+	assert := assert.New(t)
+	table, err := hebcal.GetZmanimTable(&hebcal.CalOptions{
+		Start:    hdate.FromGregorian(2024, time.March, 1),
+		End:      hdate.FromGregorian(2024, time.March, 2),
+		Location: zmanim.LookupCity("Jerusalem"),
+	}, "sunrise", "sunset")
+	assert.NoError(err)
+
+	var sb strings.Builder
+	assert.NoError(table.WriteText(&sb, "en"))
+	assert.Equal(`Date            Hebrew Date           Sunrise  Sunset
+Fri 1 Mar 2024  21st of Adar I, 5784  6:05     5:37
+Sat 2 Mar 2024  22nd of Adar I, 5784  6:04     5:38
+`, sb.String())
+
+	sb.Reset()
+	assert.NoError(table.WriteCSV(&sb))
+	assert.Equal(`date,hebrewDate,sunrise,sunset
+2024-03-01,"21st of Adar I, 5784",06:05:56,17:37:15
+2024-03-02,"22nd of Adar I, 5784",06:04:46,17:38:01
+`, sb.String())
+
+	sb.Reset()
+	assert.NoError(table.WriteHTML(&sb, "he"))
+	html := sb.String()
+	assert.True(strings.HasPrefix(html, `<table class="zmanim" dir="rtl">`))
+	assert.Contains(html, "<th>תאריך עברי</th><th>הנץ החמה</th><th>שקיעה</th>")
+	assert.Contains(html, `<td><time datetime="2024-03-01">Fri 1 Mar 2024</time></td>`)
+	assert.Contains(html, `<td><time datetime="2024-03-01T06:05:56+02:00">6:05</time></td>`)
+	assert.Equal(3, strings.Count(html, "<tr>"))
+}
@@ -0,0 +1,279 @@
+// Hebcal's icalendar package renders calendar events as an iCalendar
+// (RFC 5545) stream suitable for importing into, or subscribing from,
+// Google Calendar, Apple Calendar, Outlook and similar programs.
+//
+// This package is modeled on the @hebcal/icalendar TypeScript package.
+package icalendar
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/hebcal/locales"
+)
+
+// Options configure the calendar written by EventsToIcalendar.
+type Options struct {
+	// Location of the calendar, used for the VTIMEZONE component and as the
+	// time zone of timed events (candle-lighting, Havdalah, zmanim). It
+	// should be the same Location passed to hebcal.HebrewCalendar. If nil,
+	// timed events are written in UTC.
+	Location *zmanim.Location
+	// Locale used to render event titles (default "en").
+	Locale string
+	// Title of the calendar (X-WR-CALNAME). Defaults to "Hebcal".
+	Title string
+	// Optional description of the calendar (X-WR-CALDESC).
+	Caldesc string
+	// Append the event's emoji to each title.
+	Emoji bool
+	// Timestamp written as DTSTAMP for every event. If zero, the current
+	// time is used. Set it to produce byte-for-byte reproducible output.
+	DTStamp time.Time
+}
+
+const prodID = "-//hebcal.com/NONSGML Hebcal Calendar v1.0//EN"
+
+// localTimeFormat is the RFC 5545 DATE-TIME format for "floating" or
+// TZID-qualified local times.
+const localTimeFormat = "20060102T150405"
+
+// dateFormat is the RFC 5545 DATE format.
+const dateFormat = "20060102"
+
+// EventsToIcalendar writes events to w as a complete VCALENDAR object.
+//
+// Events that are hebcal.TimedEvent (candle-lighting, Havdalah, fast
+// begins/ends and so on) are written with a DTSTART in the Location's time
+// zone; all other events are written as all-day events. Every event gets a
+// UID derived from its date, time, title and location, so re-generating the
+// same calendar yields the same UIDs and subscribers see updates rather than
+// duplicates.
+func EventsToIcalendar(w io.Writer, events []event.CalEvent, opts *Options) error {
+	if opts == nil {
+		opts = &Options{}
+	}
+	tz := time.UTC
+	if opts.Location != nil {
+		var err error
+		tz, err = zmanim.LoadLocation(opts.Location.TimeZoneId)
+		if err != nil {
+			return err
+		}
+	}
+	stamp := opts.DTStamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	iw := &icalWriter{w: w}
+	iw.writeHeader(opts)
+	if opts.Location != nil {
+		start, end := eventsRange(events, tz)
+		iw.writeTimezone(tz, start, end)
+	}
+	dtstamp := stamp.UTC().Format(localTimeFormat) + "Z"
+	for _, ev := range events {
+		iw.writeEvent(ev, opts, tz, dtstamp)
+	}
+	iw.line("END:VCALENDAR")
+	return iw.err
+}
+
+// icalWriter writes content lines, folding them at 75 octets and remembering
+// the first write error so that callers need check it only once.
+type icalWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes a single content line terminated by CRLF, folding it as
+// required by RFC 5545 section 3.1.
+func (iw *icalWriter) line(s string) {
+	if iw.err != nil {
+		return
+	}
+	_, iw.err = io.WriteString(iw.w, foldLine(s))
+}
+
+func (iw *icalWriter) writeHeader(opts *Options) {
+	title := opts.Title
+	if title == "" {
+		title = "Hebcal"
+	}
+	iw.line("BEGIN:VCALENDAR")
+	iw.line("VERSION:2.0")
+	iw.line("PRODID:" + prodID)
+	iw.line("CALSCALE:GREGORIAN")
+	iw.line("METHOD:PUBLISH")
+	iw.line("X-LOTUS-CHARSET:UTF-8")
+	iw.line("X-PUBLISHED-TTL:PT7D")
+	iw.line("X-WR-CALNAME:" + escapeText(title))
+	if opts.Caldesc != "" {
+		iw.line("X-WR-CALDESC:" + escapeText(opts.Caldesc))
+	}
+	if opts.Location != nil {
+		iw.line("X-WR-TIMEZONE;VALUE=TEXT:" + opts.Location.TimeZoneId)
+	}
+}
+
+func (iw *icalWriter) writeEvent(ev event.CalEvent, opts *Options, tz *time.Location, dtstamp string) {
+	locale := opts.Locale
+	if locale == "" {
+		locale = "en"
+	}
+	subject := eventTitle(ev, locale)
+	if opts.Emoji {
+		if emoji := ev.GetEmoji(); emoji != "" {
+			subject += " " + emoji
+		}
+	}
+	year, month, day := ev.GetDate().Greg()
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	var dtstart, dtend, when string
+	timed, isTimed := ev.(hebcal.TimedEvent)
+	if isTimed && opts.Location != nil {
+		when = timed.EventTime.In(tz).Format(localTimeFormat)
+		dtstart = "DTSTART;TZID=" + opts.Location.TimeZoneId + ":" + when
+		dtend = "DTEND;TZID=" + opts.Location.TimeZoneId + ":" + when
+	} else if isTimed {
+		when = timed.EventTime.UTC().Format(localTimeFormat) + "Z"
+		dtstart = "DTSTART:" + when
+		dtend = "DTEND:" + when
+	} else {
+		when = date.Format(dateFormat)
+		dtstart = "DTSTART;VALUE=DATE:" + when
+		dtend = "DTEND;VALUE=DATE:" + date.AddDate(0, 0, 1).Format(dateFormat)
+	}
+	iw.line("BEGIN:VEVENT")
+	iw.line("DTSTAMP:" + dtstamp)
+	iw.line("CATEGORIES:" + strings.Join(escapeAll(ev.GetCategories()), ","))
+	iw.line("SUMMARY:" + escapeText(subject))
+	iw.line(dtstart)
+	iw.line(dtend)
+	iw.line("UID:" + makeUID(ev, date, opts.Location))
+	iw.line("TRANSP:TRANSPARENT")
+	iw.line("X-MICROSOFT-CDO-BUSYSTATUS:FREE")
+	iw.line("CLASS:PUBLIC")
+	if memo := eventMemo(ev, locale); memo != "" {
+		iw.line("DESCRIPTION:" + escapeText(memo))
+	}
+	if isTimed && opts.Location != nil {
+		iw.line("LOCATION:" + escapeText(opts.Location.Name))
+	}
+	iw.line("END:VEVENT")
+}
+
+// eventTitle returns the SUMMARY for an event. For timed events the clock
+// time is conveyed by DTSTART, so only the description is used (e.g.
+// "Candle lighting" rather than "Candle lighting: 6:27").
+func eventTitle(ev event.CalEvent, locale string) string {
+	if timed, ok := ev.(hebcal.TimedEvent); ok {
+		desc, _ := locales.LookupTranslation(timed.Desc, locale)
+		return desc
+	}
+	return ev.Render(locale)
+}
+
+// eventMemo returns a short description of the event: the linked event for
+// candle-lighting and Havdalah (e.g. "Parashat Vayera" or "Pesach I"), or the
+// translated holiday memo when one exists.
+func eventMemo(ev event.CalEvent, locale string) string {
+	if timed, ok := ev.(hebcal.TimedEvent); ok {
+		if timed.LinkedEvent != nil && (timed.Flags&event.CHANUKAH_CANDLES) == 0 {
+			return timed.LinkedEvent.Render(locale)
+		}
+		return ""
+	}
+	if memo, ok := locales.LookupTranslation("MEMO:"+ev.Basename(), locale); ok {
+		return memo
+	}
+	if memo, ok := locales.LookupTranslation("MEMO:"+ev.Basename(), "en"); ok {
+		return memo
+	}
+	return ""
+}
+
+// makeUID returns a stable, globally unique identifier for an event. The
+// same event in a re-generated calendar keeps its UID. The clock time of a
+// timed event is deliberately not part of the UID, so that a candle-lighting
+// time that shifts by a minute updates the existing event instead of adding
+// a second one.
+func makeUID(ev event.CalEvent, date time.Time, loc *zmanim.Location) string {
+	h := md5.New()
+	io.WriteString(h, eventTitle(ev, "en"))
+	if loc != nil {
+		io.WriteString(h, "\x00"+loc.Name+"\x00"+loc.TimeZoneId)
+	}
+	return "hebcal-" + date.Format(dateFormat) + "-" + hex.EncodeToString(h.Sum(nil)) + "@hebcal.com"
+}
+
+// escapeText escapes a TEXT property value (RFC 5545 section 3.3.11).
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAll(strs []string) []string {
+	result := make([]string, len(strs))
+	for i, s := range strs {
+		result[i] = escapeText(s)
+	}
+	return result
+}
+
+// maxLineOctets is the maximum length of a content line, excluding CRLF.
+const maxLineOctets = 75
+
+// foldLine returns s terminated by CRLF, split into lines of at most 75
+// octets with each continuation line starting with a single space. It never
+// splits a multi-byte UTF-8 sequence.
+func foldLine(s string) string {
+	if len(s) <= maxLineOctets {
+		return s + "\r\n"
+	}
+	var sb strings.Builder
+	sb.Grow(len(s) + 3*(len(s)/maxLineOctets+1))
+	limit := maxLineOctets
+	lineLen := 0
+	for _, r := range s {
+		n := len(string(r))
+		if lineLen+n > limit {
+			sb.WriteString("\r\n ")
+			lineLen = 0
+			limit = maxLineOctets - 1 // account for the leading space
+		}
+		sb.WriteRune(r)
+		lineLen += n
+	}
+	sb.WriteString("\r\n")
+	return sb.String()
+}
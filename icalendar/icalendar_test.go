@@ -0,0 +1,163 @@
+package icalendar_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/icalendar"
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/stretchr/testify/assert"
+)
+
+var dtstamp = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func renderIcal(t *testing.T, events []event.CalEvent, opts *icalendar.Options) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := icalendar.EventsToIcalendar(&buf, events, opts)
+	assert.NoError(t, err)
+	return buf.String()
+}
+
+// vevents splits an iCalendar stream into its (unfolded) VEVENT components.
+func vevents(ics string) [][]string {
+	unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+	var result [][]string
+	var cur []string
+	for _, line := range strings.Split(unfolded, "\r\n") {
+		switch {
+		case line == "BEGIN:VEVENT":
+			cur = []string{}
+		case line == "END:VEVENT":
+			result = append(result, cur)
+			cur = nil
+		case cur != nil && !strings.HasPrefix(line, "DTSTAMP:") && !strings.HasPrefix(line, "UID:"):
+			cur = append(cur, line)
+		}
+	}
+	return result
+}
+
+func TestEventsToIcalendar(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.LookupCity("Chicago")
+	events, err := hebcal.HebrewCalendar(&hebcal.CalOptions{
+		Start:          hdate.New(5782, hdate.Elul, 29),
+		End:            hdate.New(5783, hdate.Tishrei, 1),
+		CandleLighting: true,
+		Location:       loc,
+	})
+	assert.NoError(err)
+	ics := renderIcal(t, events, &icalendar.Options{Location: loc, Title: "Hebcal Chicago", DTStamp: dtstamp})
+
+	assert.True(strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	assert.Contains(ics, "\r\nX-WR-CALNAME:Hebcal Chicago\r\n")
+	assert.Contains(ics, "\r\nX-WR-TIMEZONE;VALUE=TEXT:America/Chicago\r\n")
+	assert.Contains(ics, "\r\nDTSTAMP:20220101T000000Z\r\n")
+	assert.Contains(ics, "BEGIN:VTIMEZONE\r\nTZID:America/Chicago\r\n")
+	assert.Contains(ics, "BEGIN:STANDARD\r\nDTSTART:20211107T020000\r\n"+
+		"TZOFFSETFROM:-0500\r\nTZOFFSETTO:-0600\r\nTZNAME:CST\r\nEND:STANDARD\r\n")
+	assert.Contains(ics, "BEGIN:DAYLIGHT\r\nDTSTART:20220313T020000\r\n"+
+		"TZOFFSETFROM:-0600\r\nTZOFFSETTO:-0500\r\nTZNAME:CDT\r\nEND:DAYLIGHT\r\n")
+
+	expected := [][]string{
+		{
+			"CATEGORIES:holiday,major",
+			"SUMMARY:Erev Rosh Hashana",
+			"DTSTART;VALUE=DATE:20220925",
+			"DTEND;VALUE=DATE:20220926",
+			"TRANSP:TRANSPARENT",
+			"X-MICROSOFT-CDO-BUSYSTATUS:FREE",
+			"CLASS:PUBLIC",
+			"DESCRIPTION:The Jewish New Year. Also spelled Rosh Hashanah",
+		},
+		{
+			"CATEGORIES:candles",
+			"SUMMARY:Candle lighting",
+			"DTSTART;TZID=America/Chicago:20220925T182400",
+			"DTEND;TZID=America/Chicago:20220925T182400",
+			"TRANSP:TRANSPARENT",
+			"X-MICROSOFT-CDO-BUSYSTATUS:FREE",
+			"CLASS:PUBLIC",
+			"DESCRIPTION:Erev Rosh Hashana",
+			"LOCATION:Chicago",
+		},
+		{
+			"CATEGORIES:holiday,major",
+			"SUMMARY:Rosh Hashana 5783",
+			"DTSTART;VALUE=DATE:20220926",
+			"DTEND;VALUE=DATE:20220927",
+			"TRANSP:TRANSPARENT",
+			"X-MICROSOFT-CDO-BUSYSTATUS:FREE",
+			"CLASS:PUBLIC",
+			"DESCRIPTION:The Jewish New Year. Also spelled Rosh Hashanah",
+		},
+		{
+			"CATEGORIES:candles",
+			"SUMMARY:Candle lighting",
+			"DTSTART;TZID=America/Chicago:20220926T192200",
+			"DTEND;TZID=America/Chicago:20220926T192200",
+			"TRANSP:TRANSPARENT",
+			"X-MICROSOFT-CDO-BUSYSTATUS:FREE",
+			"CLASS:PUBLIC",
+			"DESCRIPTION:Rosh Hashana 5783",
+			"LOCATION:Chicago",
+		},
+	}
+	assert.Equal(expected, vevents(ics))
+}
+
+func TestEventsToIcalendarStableUID(t *testing.T) {
+	assert := assert.New(t)
+	opts := &hebcal.CalOptions{Year: 2023, Month: time.March}
+	events1, _ := hebcal.HebrewCalendar(opts)
+	events2, _ := hebcal.HebrewCalendar(&hebcal.CalOptions{Year: 2023, Month: time.March})
+	ics1 := renderIcal(t, events1, &icalendar.Options{})
+	ics2 := renderIcal(t, events2, &icalendar.Options{DTStamp: dtstamp})
+	uids := func(ics string) []string {
+		var result []string
+		for _, line := range strings.Split(ics, "\r\n") {
+			if strings.HasPrefix(line, "UID:") {
+				result = append(result, line)
+			}
+		}
+		return result
+	}
+	u1, u2 := uids(ics1), uids(ics2)
+	assert.Equal(len(events1), len(u1))
+	assert.Equal(u1, u2)
+	seen := make(map[string]bool)
+	for _, uid := range u1 {
+		assert.False(seen[uid], "duplicate %s", uid)
+		seen[uid] = true
+	}
+}
+
+func TestEventsToIcalendarHebrewFolding(t *testing.T) {
+	assert := assert.New(t)
+	hd := hdate.New(5783, hdate.Tishrei, 1)
+	events := []event.CalEvent{
+		event.UserEvent{Date: hd, Desc: "Yahrzeit; of a very long name, with commas\nand a newline " +
+			strings.Repeat("שָׁלוֹם ", 12)},
+	}
+	ics := renderIcal(t, events, &icalendar.Options{Locale: "he", DTStamp: dtstamp})
+	for _, line := range strings.Split(ics, "\r\n") {
+		assert.LessOrEqual(len(line), 75, line)
+		assert.True(utf8.ValidString(line), line)
+	}
+	summary := ""
+	for _, line := range vevents(ics)[0] {
+		if strings.HasPrefix(line, "SUMMARY:") {
+			summary = line
+		}
+	}
+	assert.True(strings.HasPrefix(summary, `SUMMARY:Yahrzeit\; of a very long name\, with commas\nand a newline שָׁלוֹם`), summary)
+	assert.NotContains(ics, "VTIMEZONE")
+}
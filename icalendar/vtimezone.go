@@ -0,0 +1,153 @@
+package icalendar
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hebcal/hebcal-go/event"
+)
+
+// eventsRange returns the first and last Gregorian days spanned by events,
+// as midnight in tz. For an empty slice it returns the current day.
+func eventsRange(events []event.CalEvent, tz *time.Location) (time.Time, time.Time) {
+	if len(events) == 0 {
+		now := time.Now().In(tz)
+		day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, tz)
+		return day, day
+	}
+	first, last := events[0].GetDate(), events[0].GetDate()
+	for _, ev := range events[1:] {
+		hd := ev.GetDate()
+		if hd.Abs() < first.Abs() {
+			first = hd
+		}
+		if hd.Abs() > last.Abs() {
+			last = hd
+		}
+	}
+	fy, fm, fd := first.Greg()
+	ly, lm, ld := last.Greg()
+	return time.Date(fy, fm, fd, 0, 0, 0, 0, tz), time.Date(ly, lm, ld, 0, 0, 0, 0, tz)
+}
+
+// tzTransition is a change of UTC offset (and usually of abbreviation),
+// such as the start or end of daylight saving time.
+type tzTransition struct {
+	at         time.Time // instant of the change
+	fromOffset int       // seconds east of UTC before the change
+	toOffset   int       // seconds east of UTC after the change
+	name       string    // abbreviation after the change, e.g. "CDT"
+	isDST      bool      // whether daylight saving time is in effect after the change
+}
+
+// findTransitions returns the UTC offset changes of tz between start and
+// end, found by probing once a day and then bisecting down to the second.
+// The Go time package does not expose a zone's transition table, but the
+// handful of changes per year makes probing cheap.
+func findTransitions(tz *time.Location, start, end time.Time) []tzTransition {
+	var result []tzTransition
+	prev := start
+	_, prevOffset := prev.Zone()
+	for t := start.Add(24 * time.Hour); !t.After(end.Add(24 * time.Hour)); t = t.Add(24 * time.Hour) {
+		_, offset := t.Zone()
+		if offset != prevOffset {
+			at := bisectTransition(prev, t, prevOffset)
+			name, toOffset := at.In(tz).Zone()
+			result = append(result, tzTransition{
+				at:         at,
+				fromOffset: prevOffset,
+				toOffset:   toOffset,
+				name:       name,
+				isDST:      at.In(tz).IsDST(),
+			})
+			prevOffset = offset
+		}
+		prev = t
+	}
+	return result
+}
+
+// bisectTransition returns the first second in (lo, hi] at which the UTC
+// offset differs from loOffset.
+func bisectTransition(lo, hi time.Time, loOffset int) time.Time {
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+		if _, offset := mid.Zone(); offset == loOffset {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// formatOffset formats a UTC offset in seconds as an RFC 5545 UTC-OFFSET,
+// e.g. "-0500" or "+0530".
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	hours := offset / 3600
+	minutes := (offset % 3600) / 60
+	seconds := offset % 60
+	if seconds != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, hours, minutes)
+}
+
+// writeTimezone writes a VTIMEZONE component for tz that is valid for every
+// instant between start and end. Rather than approximating the zone with
+// RRULEs, each observance in the range is written explicitly, which is
+// always accurate (including for zones whose rules changed over time).
+func (iw *icalWriter) writeTimezone(tz *time.Location, start, end time.Time) {
+	// Start a year early so that a client expanding the zone from the first
+	// event backwards still finds an observance in effect.
+	start = start.AddDate(-1, 0, 0)
+	end = end.AddDate(0, 0, 1)
+	iw.line("BEGIN:VTIMEZONE")
+	iw.line("TZID:" + tz.String())
+	name, offset := start.Zone()
+	iw.writeObservance(start.IsDST(), start.Add(time.Duration(offset)*time.Second), offset, offset, name)
+	for _, tr := range findTransitions(tz, start, end) {
+		// DTSTART of an observance is the local time in the offset that was
+		// in effect before the transition (RFC 5545 section 3.6.5).
+		local := tr.at.Add(time.Duration(tr.fromOffset) * time.Second)
+		iw.writeObservance(tr.isDST, local, tr.fromOffset, tr.toOffset, tr.name)
+	}
+	iw.line("END:VTIMEZONE")
+}
+
+// writeObservance writes a single STANDARD or DAYLIGHT sub-component. local
+// is the onset expressed as a wall-clock time in the previous offset; only
+// its UTC fields are used.
+func (iw *icalWriter) writeObservance(isDST bool, local time.Time, fromOffset, toOffset int, name string) {
+	kind := "STANDARD"
+	if isDST {
+		kind = "DAYLIGHT"
+	}
+	iw.line("BEGIN:" + kind)
+	iw.line("DTSTART:" + local.UTC().Format(localTimeFormat))
+	iw.line("TZOFFSETFROM:" + formatOffset(fromOffset))
+	iw.line("TZOFFSETTO:" + formatOffset(toOffset))
+	iw.line("TZNAME:" + name)
+	iw.line("END:" + kind)
+}
@@ -0,0 +1,463 @@
+package leyning
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+)
+
+// holidayReading is the Torah and Haftara reading of a holiday. Each
+// verse range includes its book, e.g. "Numbers 29:1-6". Shabbat is the
+// division into seven aliyot when the holiday falls on Shabbat, or nil if
+// it never does or the reading is the same.
+type holidayReading struct {
+	aliyot           []string
+	shabbat          []string
+	maftir           string
+	haftara          string
+	haftaraSephardic string
+}
+
+// The megillot, by their English book names.
+const (
+	shirHaShirim = "Song of Songs 1:1-8:14"
+	ruth         = "Ruth 1:1-4:22"
+	eicha        = "Lamentations 1:1-5:22"
+	kohelet      = "Ecclesiastes 1:1-12:14"
+	esther       = "Esther 1:1-10:3"
+)
+
+// The Yom Tov reading of Leviticus 22-23 (Sukkot and the second day of
+// Pesach), and Deuteronomy 15-16 (the last day of each festival in the
+// Diaspora), which on Shabbat begins earlier at 14:22.
+var (
+	emorAliyot = []string{"Leviticus 22:26-23:3", "Leviticus 23:4-14", "Leviticus 23:15-22",
+		"Leviticus 23:23-32", "Leviticus 23:33-44"}
+	emorShabbat = []string{"Leviticus 22:26-33", "Leviticus 23:1-3", "Leviticus 23:4-8",
+		"Leviticus 23:9-14", "Leviticus 23:15-22", "Leviticus 23:23-32", "Leviticus 23:33-44"}
+	reehAliyot = []string{"Deuteronomy 15:19-23", "Deuteronomy 16:1-3", "Deuteronomy 16:4-8",
+		"Deuteronomy 16:9-12", "Deuteronomy 16:13-17"}
+	reehShabbat = []string{"Deuteronomy 14:22-29", "Deuteronomy 15:1-18", "Deuteronomy 15:19-23",
+		"Deuteronomy 16:1-3", "Deuteronomy 16:4-8", "Deuteronomy 16:9-12", "Deuteronomy 16:13-17"}
+)
+
+// fastReading is read at Shacharit and Mincha on public fast days.
+var fastReading = holidayReading{
+	aliyot: []string{"Exodus 32:11-14", "Exodus 34:1-3", "Exodus 34:4-10"},
+}
+
+const fastMinchaHaftara = "Isaiah 55:6-56:8"
+
+// yomKippurMincha is read at Mincha on Yom Kippur.
+var yomKippurMincha = []string{"Leviticus 18:1-5", "Leviticus 18:6-21", "Leviticus 18:22-30"}
+
+// shabbatCholHaMoed is read on Shabbat Chol HaMoed of both Pesach and
+// Sukkot, followed by the maftir of the day.
+var shabbatCholHaMoed = []string{"Exodus 33:12-16", "Exodus 33:17-19", "Exodus 33:20-23",
+	"Exodus 34:1-3", "Exodus 34:4-10", "Exodus 34:11-17", "Exodus 34:18-26"}
+
+// holidayReadings is keyed by the event description, without the year
+// for Rosh Hashana.
+var holidayReadings = map[string]holidayReading{
+	"Rosh Hashana": {
+		aliyot: []string{"Genesis 21:1-4", "Genesis 21:5-12", "Genesis 21:13-17",
+			"Genesis 21:18-21", "Genesis 21:22-34"},
+		shabbat: []string{"Genesis 21:1-4", "Genesis 21:5-8", "Genesis 21:9-12",
+			"Genesis 21:13-17", "Genesis 21:18-21", "Genesis 21:22-27", "Genesis 21:28-34"},
+		maftir:  "Numbers 29:1-6",
+		haftara: "I Samuel 1:1-2:10",
+	},
+	"Rosh Hashana II": {
+		aliyot: []string{"Genesis 22:1-3", "Genesis 22:4-8", "Genesis 22:9-14",
+			"Genesis 22:15-19", "Genesis 22:20-24"},
+		maftir:  "Numbers 29:1-6",
+		haftara: "Jeremiah 31:1-19",
+	},
+	"Yom Kippur": {
+		aliyot: []string{"Leviticus 16:1-6", "Leviticus 16:7-11", "Leviticus 16:12-17",
+			"Leviticus 16:18-24", "Leviticus 16:25-30", "Leviticus 16:31-34"},
+		shabbat: []string{"Leviticus 16:1-3", "Leviticus 16:4-6", "Leviticus 16:7-11",
+			"Leviticus 16:12-17", "Leviticus 16:18-24", "Leviticus 16:25-30", "Leviticus 16:31-34"},
+		maftir:  "Numbers 29:7-11",
+		haftara: "Isaiah 57:14-58:14",
+	},
+	"Sukkot I": {
+		aliyot:  emorAliyot,
+		shabbat: emorShabbat,
+		maftir:  "Numbers 29:12-16",
+		haftara: "Zechariah 14:1-21",
+	},
+	"Sukkot II": {
+		aliyot:  emorAliyot,
+		maftir:  "Numbers 29:12-16",
+		haftara: "I Kings 8:2-21",
+	},
+	"Shmini Atzeret": {
+		aliyot:  reehAliyot,
+		shabbat: reehShabbat,
+		maftir:  "Numbers 29:35-30:1",
+		haftara: "I Kings 8:54-66",
+	},
+	"Simchat Torah": {
+		aliyot: []string{"Deuteronomy 33:1-7", "Deuteronomy 33:8-12", "Deuteronomy 33:13-17",
+			"Deuteronomy 33:18-21", "Deuteronomy 33:22-26", "Deuteronomy 33:27-34:12",
+			"Genesis 1:1-2:3"},
+		maftir:           "Numbers 29:35-30:1",
+		haftara:          "Joshua 1:1-18",
+		haftaraSephardic: "Joshua 1:1-9",
+	},
+	"Pesach I": {
+		aliyot: []string{"Exodus 12:21-24", "Exodus 12:25-28", "Exodus 12:29-36",
+			"Exodus 12:37-42", "Exodus 12:43-51"},
+		shabbat: []string{"Exodus 12:21-24", "Exodus 12:25-28", "Exodus 12:29-32",
+			"Exodus 12:33-36", "Exodus 12:37-42", "Exodus 12:43-47", "Exodus 12:48-51"},
+		maftir:  "Numbers 28:16-25",
+		haftara: "Joshua 3:5-7; 5:2-6:1; 6:27",
+	},
+	"Pesach II": {
+		aliyot:  emorAliyot,
+		maftir:  "Numbers 28:16-25",
+		haftara: "II Kings 23:1-9; 23:21-25",
+	},
+	"Pesach VII": {
+		aliyot: []string{"Exodus 13:17-22", "Exodus 14:1-8", "Exodus 14:9-14",
+			"Exodus 14:15-25", "Exodus 14:26-15:26"},
+		shabbat: []string{"Exodus 13:17-22", "Exodus 14:1-4", "Exodus 14:5-8",
+			"Exodus 14:9-14", "Exodus 14:15-25", "Exodus 14:26-15:21", "Exodus 15:22-26"},
+		maftir:  "Numbers 28:19-25",
+		haftara: "II Samuel 22:1-51",
+	},
+	"Pesach VIII": {
+		aliyot:  reehAliyot,
+		shabbat: reehShabbat,
+		maftir:  "Numbers 28:19-25",
+		haftara: "Isaiah 10:32-12:6",
+	},
+	"Shavuot I": {
+		aliyot: []string{"Exodus 19:1-6", "Exodus 19:7-13", "Exodus 19:14-19",
+			"Exodus 19:20-20:14", "Exodus 20:15-23"},
+		shabbat: []string{"Exodus 19:1-6", "Exodus 19:7-13", "Exodus 19:14-19",
+			"Exodus 19:20-25", "Exodus 20:1-14", "Exodus 20:15-18", "Exodus 20:19-23"},
+		maftir:  "Numbers 28:26-31",
+		haftara: "Ezekiel 1:1-28; 3:12",
+	},
+	"Shavuot II": {
+		aliyot:  reehAliyot,
+		shabbat: reehShabbat,
+		maftir:  "Numbers 28:26-31",
+		haftara: "Habakkuk 2:20-3:19",
+	},
+	"Purim": {
+		aliyot: []string{"Exodus 17:8-10", "Exodus 17:11-13", "Exodus 17:14-16"},
+	},
+	"Tish'a B'Av": {
+		aliyot:  []string{"Deuteronomy 4:25-29", "Deuteronomy 4:30-35", "Deuteronomy 4:36-40"},
+		haftara: "Jeremiah 8:13-9:23",
+	},
+	"Tzom Gedaliah":  fastReading,
+	"Asara B'Tevet":  fastReading,
+	"Ta'anit Esther": fastReading,
+	"Tzom Tammuz":    fastReading,
+	"Rosh Chodesh": {
+		aliyot: []string{"Numbers 28:1-3", "Numbers 28:3-5", "Numbers 28:6-10", "Numbers 28:11-15"},
+	},
+}
+
+// In Israel, the single day of Shavuot has the reading of its first day
+// in the Diaspora, and Shmini Atzeret that of Simchat Torah.
+var holidayReadingsIL = map[string]string{
+	"Shavuot":        "Shavuot I",
+	"Shmini Atzeret": "Simchat Torah",
+}
+
+// The weekday readings of Chol HaMoed Pesach, in order. Each is followed
+// by the maftir of Pesach as a fourth aliyah. In Israel, where Chol HaMoed
+// begins a day earlier, the reading of the second day of Pesach is read
+// first. The reading of Exodus 34 is omitted when Shabbat falls on Chol
+// HaMoed, since it is read on that Shabbat.
+var (
+	pesachCholHaMoedIL = []string{"Leviticus 22:26-23:3", "Leviticus 23:4-14", "Leviticus 23:15-44"}
+	pesachCholHaMoed   = [][]string{
+		{"Exodus 13:1-4", "Exodus 13:5-10", "Exodus 13:11-16"},
+		{"Exodus 22:24-26", "Exodus 22:27-23:5", "Exodus 23:6-19"},
+		{"Exodus 34:1-3", "Exodus 34:4-17", "Exodus 34:18-26"},
+		{"Numbers 9:1-5", "Numbers 9:6-8", "Numbers 9:9-14"},
+	}
+)
+
+const (
+	pesachMaftir           = "Numbers 28:19-25"
+	sukkotShabbatHaftara   = "Ezekiel 38:18-39:16"
+	pesachShabbatHaftara   = "Ezekiel 37:1-14"
+	yomKippurMinchaHaftara = "Jonah 1:1-4:11; Micah 7:18-20"
+)
+
+// parseReading parses a verse range that includes its book, e.g.
+// "Numbers 28:1-15" or "I Samuel 1:1-2:10".
+func parseReading(s string) Aliyah {
+	i := strings.LastIndex(s, " ")
+	return parseAliyah(s[:i], s[i+1:])
+}
+
+func parseReadings(ranges []string) []Aliyah {
+	result := make([]Aliyah, len(ranges))
+	for i, s := range ranges {
+		result[i] = parseReading(s)
+	}
+	return result
+}
+
+// newHolidayLeyning returns a Leyning with the given aliyot, maftir and
+// haftara, summarizing the verses read.
+func newHolidayLeyning(name string, aliyot []Aliyah, maftir string, haftara, sephardic string) Leyning {
+	l := Leyning{Name: name, Aliyot: aliyot, Haftara: haftara, HaftaraSephardic: sephardic}
+	all := aliyot
+	if maftir != "" {
+		l.Maftir = parseReading(maftir)
+		all = append(all[:len(all):len(all)], l.Maftir)
+	}
+	l.Summary = summarize(all)
+	return l
+}
+
+// holidayKey returns the key of ev in holidayReadings.
+func holidayKey(ev event.HolidayEvent, il bool) string {
+	if (ev.Flags & event.ROSH_CHODESH) != 0 {
+		return "Rosh Chodesh"
+	}
+	if ev.Desc == "Rosh Hashana "+strconv.Itoa(ev.Date.Year()) {
+		return "Rosh Hashana"
+	}
+	key := strings.TrimSuffix(ev.Desc, " (observed)")
+	if il {
+		if k, ok := holidayReadingsIL[key]; ok {
+			return k
+		}
+	}
+	return key
+}
+
+// GetLeyningForHoliday returns the reading at Shacharit on the holiday ev,
+// for either the Diaspora (il=false) or Israel (il=true): Yom Tov, Chol
+// HaMoed (including Shabbat Chol HaMoed), Rosh Chodesh, Chanukah, Purim
+// and the public fast days. Megillah is set on the days on which one of
+// the five megillot is read in the morning.
+//
+// It returns false for holidays without a Torah reading of their own,
+// including Rosh Chodesh and Chanukah when they fall on Shabbat, when the
+// weekly parsha is read (see GetLeyningOnDate).
+func GetLeyningForHoliday(ev event.HolidayEvent, il bool) (Leyning, bool) {
+	hd := ev.Date
+	isShabbat := hd.Weekday() == time.Saturday
+	key := holidayKey(ev, il)
+	var l Leyning
+	switch {
+	case ev.ChanukahDay > 0 || (key == "Rosh Chodesh" && chanukahDay(hd) > 0):
+		if isShabbat {
+			return Leyning{}, false
+		}
+		l = chanukahLeyning(ev.Desc, hd)
+	case (ev.Flags & event.CHOL_HAMOED) != 0:
+		l = cholHaMoedLeyning(ev.Desc, hd, il)
+	default:
+		r, ok := holidayReadings[key]
+		if !ok || (isShabbat && key == "Rosh Chodesh") {
+			return Leyning{}, false
+		}
+		ranges := r.aliyot
+		if isShabbat && r.shabbat != nil {
+			ranges = r.shabbat
+		}
+		l = newHolidayLeyning(ev.Desc, parseReadings(ranges), r.maftir, r.haftara, r.haftaraSephardic)
+	}
+	l.Megillah = megillah(hd, key, il)
+	return l, true
+}
+
+// GetMinchaLeyningForHoliday returns the reading at Mincha on Yom Kippur,
+// Tish'a B'Av and the minor fast days. It returns false for all other
+// holidays.
+func GetMinchaLeyningForHoliday(ev event.HolidayEvent, il bool) (Leyning, bool) {
+	switch holidayKey(ev, il) {
+	case "Yom Kippur":
+		return newHolidayLeyning(ev.Desc, parseReadings(yomKippurMincha), "", yomKippurMinchaHaftara, ""), true
+	case "Tish'a B'Av", "Tzom Gedaliah", "Asara B'Tevet", "Ta'anit Esther", "Tzom Tammuz":
+		return newHolidayLeyning(ev.Desc, parseReadings(fastReading.aliyot), "", fastMinchaHaftara, ""), true
+	}
+	return Leyning{}, false
+}
+
+// GetLeyningForHolidayOnDate returns the reading at Shacharit for the
+// holiday on hd, as GetLeyningForHoliday. It returns false if there is no
+// holiday reading on hd.
+func GetLeyningForHolidayOnDate(hd hdate.HDate, il bool) (Leyning, bool) {
+	for _, ev := range hebcal.GetHolidaysOnDate(hd, il) {
+		if l, ok := GetLeyningForHoliday(ev, il); ok {
+			return l, true
+		}
+	}
+	return Leyning{}, false
+}
+
+// chanukahDay returns the day (1-8) of Chanukah on hd, or 0.
+func chanukahDay(hd hdate.HDate) int {
+	day := int(hd.Abs()-hdate.New(hd.Year(), hdate.Kislev, 25).Abs()) + 1
+	if day < 1 || day > 8 {
+		return 0
+	}
+	return day
+}
+
+// chanukahLeyning returns the weekday reading on hd during Chanukah. The
+// third aliyah continues with the following day's prince (Ashkenazic
+// custom). On Rosh Chodesh Tevet, three aliyot read the Rosh Chodesh
+// offering and the fourth the day of Chanukah.
+func chanukahLeyning(name string, hd hdate.HDate) Leyning {
+	day := chanukahDay(hd)
+	var ranges []string
+	switch {
+	case hd.Day() == 30 || hd.Day() == 1:
+		ranges = []string{"Numbers 28:1-5", "Numbers 28:6-10", "Numbers 28:11-15"}
+		aliyot := append(parseReadings(ranges), chanukahReading(day))
+		return newHolidayLeyning(name, aliyot, "", "", "")
+	case day == 1:
+		ranges = []string{"Numbers 7:1-11", "Numbers 7:12-14", "Numbers 7:15-17"}
+	case day == 8:
+		ranges = []string{"Numbers 7:54-56", "Numbers 7:57-59", "Numbers 7:60-8:4"}
+	default:
+		begin := 18 + 6*(day-2)
+		ranges = []string{
+			numbersRange(7, begin, begin+2),
+			numbersRange(7, begin+3, begin+5),
+			numbersRange(7, begin+6, begin+11),
+		}
+	}
+	return newHolidayLeyning(name, parseReadings(ranges), "", "", "")
+}
+
+// numbersRange formats verses begin through end of chapter of Numbers.
+func numbersRange(chapter, begin, end int) string {
+	c := strconv.Itoa(chapter)
+	return "Numbers " + c + ":" + strconv.Itoa(begin) + "-" + strconv.Itoa(end)
+}
+
+// sukkotKorbanot returns the offerings of days from through to (2-7) of
+// Sukkot, from Numbers 29.
+func sukkotKorbanot(from, to int) string {
+	return numbersRange(29, 17+3*(from-2), 19+3*(to-2))
+}
+
+// cholHaMoedLeyning returns the reading on hd during Chol HaMoed.
+func cholHaMoedLeyning(name string, hd hdate.HDate, il bool) Leyning {
+	day := hd.Day()
+	isShabbat := hd.Weekday() == time.Saturday
+	if hd.Month() == hdate.Tishrei {
+		sukkotDay := day - 14
+		// In the Diaspora, where the day of Sukkot is in doubt, the
+		// offerings of both possible days are read.
+		first := sukkotDay - 1
+		if il {
+			first = sukkotDay
+		}
+		if isShabbat {
+			return newHolidayLeyning(name, parseReadings(shabbatCholHaMoed),
+				sukkotKorbanot(first, sukkotDay), sukkotShabbatHaftara, "")
+		}
+		var ranges []string
+		if il {
+			// each aliyah reads the offering of the day
+			k := sukkotKorbanot(sukkotDay, sukkotDay)
+			ranges = []string{k, k, k, k}
+		} else {
+			if first > 5 {
+				first = 5
+			}
+			ranges = []string{
+				sukkotKorbanot(first, first),
+				sukkotKorbanot(first+1, first+1),
+				sukkotKorbanot(first+2, first+2),
+				sukkotKorbanot(sukkotDay-1, sukkotDay),
+			}
+		}
+		return newHolidayLeyning(name, parseReadings(ranges), "", "", "")
+	}
+	if isShabbat {
+		return newHolidayLeyning(name, parseReadings(shabbatCholHaMoed), pesachMaftir, pesachShabbatHaftara, "")
+	}
+	firstDay := 17
+	readings := pesachCholHaMoed
+	if il {
+		firstDay = 16
+		readings = append([][]string{pesachCholHaMoedIL}, readings...)
+	}
+	start := hdate.New(hd.Year(), hdate.Nisan, firstDay).Abs()
+	end := hdate.New(hd.Year(), hdate.Nisan, 20).Abs()
+	if hdate.DayOnOrBefore(time.Saturday, end) >= start {
+		// Exodus 34 is read on Shabbat Chol HaMoed
+		readings = append(readings[:len(readings)-2:len(readings)-2], readings[len(readings)-1])
+	}
+	weekdays := 0
+	for abs := start; abs < hd.Abs(); abs++ {
+		if hdate.FromRD(abs).Weekday() != time.Saturday {
+			weekdays++
+		}
+	}
+	ranges := append(readings[weekdays][:3:3], pesachMaftir)
+	return newHolidayLeyning(name, parseReadings(ranges), "", "", "")
+}
+
+// megillah returns the megillah read on hd, or "".
+//
+// Kohelet is read on Shabbat Chol HaMoed Sukkot, or on Shmini Atzeret when
+// it falls on Shabbat, and Shir HaShirim on the Shabbat of Pesach (in the
+// Diaspora, on the eighth day if both the first and the eighth are
+// Shabbat): in both cases, on the last Shabbat of the festival.
+func megillah(hd hdate.HDate, key string, il bool) string {
+	switch {
+	case key == "Purim":
+		return esther
+	case key == "Tish'a B'Av":
+		return eicha
+	case key == "Shavuot II" || (il && key == "Shavuot I"):
+		return ruth
+	}
+	if hd.Weekday() != time.Saturday {
+		return ""
+	}
+	day := hd.Day()
+	switch hd.Month() {
+	case hdate.Tishrei:
+		if day >= 15 && day+7 > 22 {
+			return kohelet
+		}
+	case hdate.Nisan:
+		last := 22
+		if il {
+			last = 21
+		}
+		if day >= 15 && day+7 > last {
+			return shirHaShirim
+		}
+	}
+	return ""
+}
@@ -0,0 +1,189 @@
+package leyning_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/leyning"
+	"github.com/hebcal/hebcal-go/sedra"
+)
+
+func holidayLeyning(t *testing.T, hd hdate.HDate, il bool) leyning.Leyning {
+	l, ok := leyning.GetLeyningForHolidayOnDate(hd, il)
+	assert.True(t, ok, hd.String())
+	return l
+}
+
+func TestGetLeyningForHolidayYomTov(t *testing.T) {
+	assert := assert.New(t)
+	l := holidayLeyning(t, hdate.New(5783, hdate.Tishrei, 1), false)
+	assert.Equal("Rosh Hashana 5783", l.Name)
+	assert.Equal("Genesis 21:1-34; Numbers 29:1-6", l.Summary)
+	assert.Equal(5, len(l.Aliyot))
+	assert.Equal("Numbers 29:1-6", l.Maftir.String())
+	assert.Equal("I Samuel 1:1-2:10", l.Haftara)
+
+	// Rosh Hashana 5781 fell on Shabbat
+	l = holidayLeyning(t, hdate.New(5781, hdate.Tishrei, 1), false)
+	assert.Equal(7, len(l.Aliyot))
+	assert.Equal("Genesis 21:28-34", l.Aliyot[6].String())
+
+	l = holidayLeyning(t, hdate.New(5783, hdate.Tishrei, 22), false)
+	assert.Equal("Deuteronomy 15:19-16:17; Numbers 29:35-30:1", l.Summary)
+	assert.Equal("", l.Megillah)
+
+	// in Israel, Shmini Atzeret is also Simchat Torah
+	l = holidayLeyning(t, hdate.New(5783, hdate.Tishrei, 22), true)
+	assert.Equal("Shmini Atzeret", l.Name)
+	assert.Equal("Deuteronomy 33:1-34:12; Genesis 1:1-2:3; Numbers 29:35-30:1", l.Summary)
+	assert.Equal("Joshua 1:1-18", l.Haftara)
+	assert.Equal("Joshua 1:1-9", l.HaftaraSephardic)
+
+	l = holidayLeyning(t, hdate.New(5783, hdate.Sivan, 7), false)
+	assert.Equal("Shavuot II", l.Name)
+	assert.Equal("Ruth 1:1-4:22", l.Megillah)
+	l = holidayLeyning(t, hdate.New(5783, hdate.Sivan, 6), true)
+	assert.Equal("Exodus 19:1-20:23; Numbers 28:26-31", l.Summary)
+	assert.Equal("Ruth 1:1-4:22", l.Megillah)
+}
+
+func TestGetLeyningForHolidayCholHaMoed(t *testing.T) {
+	assert := assert.New(t)
+	l := holidayLeyning(t, hdate.New(5783, hdate.Tishrei, 17), false)
+	assert.Equal([]string{
+		"Numbers 29:17-19",
+		"Numbers 29:20-22",
+		"Numbers 29:23-25",
+		"Numbers 29:17-22",
+	}, aliyotStrings(l)[:4])
+	assert.Equal("Numbers 29:17-25", l.Summary)
+	l = holidayLeyning(t, hdate.New(5783, hdate.Tishrei, 17), true)
+	assert.Equal("Numbers 29:20-22", l.Summary)
+
+	// Shabbat Chol HaMoed Sukkot
+	l = holidayLeyning(t, hdate.New(5783, hdate.Tishrei, 20), false)
+	assert.Equal("Exodus 33:12-34:26; Numbers 29:26-31", l.Summary)
+	assert.Equal("Ezekiel 38:18-39:16", l.Haftara)
+	assert.Equal("Ecclesiastes 1:1-12:14", l.Megillah)
+
+	// Hoshana Raba
+	l = holidayLeyning(t, hdate.New(5783, hdate.Tishrei, 21), false)
+	assert.Equal([]string{
+		"Numbers 29:26-28",
+		"Numbers 29:29-31",
+		"Numbers 29:32-34",
+		"Numbers 29:29-34",
+	}, aliyotStrings(l)[:4])
+
+	// Pesach 5783 began on Thursday, so Shabbat was 17 Nisan
+	l = holidayLeyning(t, hdate.New(5783, hdate.Nisan, 17), false)
+	assert.Equal("Exodus 33:12-34:26; Numbers 28:19-25", l.Summary)
+	assert.Equal("Song of Songs 1:1-8:14", l.Megillah)
+	for il, expected := range map[bool]map[int]string{
+		false: {18: "Exodus 13:1-16", 19: "Exodus 22:24-23:19", 20: "Numbers 9:1-14; 28:19-25"},
+		true: {16: "Leviticus 22:26-23:44", 18: "Exodus 13:1-16",
+			19: "Exodus 22:24-23:19", 20: "Numbers 9:1-14; 28:19-25"},
+	} {
+		for day, torah := range expected {
+			l = holidayLeyning(t, hdate.New(5783, hdate.Nisan, day), il)
+			if !strings.HasPrefix(torah, "Numbers") {
+				torah += "; Numbers 28:19-25"
+			}
+			assert.Equal(torah, l.Summary, day)
+		}
+	}
+	// without Shabbat Chol HaMoed, Exodus 34 is read on a weekday
+	l = holidayLeyning(t, hdate.New(5778, hdate.Nisan, 19), false)
+	assert.Equal("Exodus 34:1-26; Numbers 28:19-25", l.Summary)
+}
+
+func TestGetLeyningForHolidayChanukah(t *testing.T) {
+	assert := assert.New(t)
+	l := holidayLeyning(t, hdate.New(5783, hdate.Kislev, 26), false)
+	assert.Equal("Chanukah: 3 Candles", l.Name)
+	assert.Equal([]string{"Numbers 7:18-20", "Numbers 7:21-23", "Numbers 7:24-29"}, aliyotStrings(l)[:3])
+	assert.Equal("", l.Haftara)
+
+	// Rosh Chodesh Tevet, the seventh day of Chanukah
+	l = holidayLeyning(t, hdate.New(5783, hdate.Tevet, 1), false)
+	assert.Equal("Numbers 28:1-15; 7:48-53", l.Summary)
+	assert.Equal(4, len(l.Aliyot))
+
+	// on Shabbat, the weekly parsha is read
+	_, ok := leyning.GetLeyningForHolidayOnDate(hdate.New(5783, hdate.Kislev, 30), false)
+	assert.False(ok)
+}
+
+func TestGetLeyningForHolidayWeekdays(t *testing.T) {
+	assert := assert.New(t)
+	l := holidayLeyning(t, hdate.New(5783, hdate.Nisan, 30), false)
+	assert.Equal("Rosh Chodesh Iyyar", l.Name)
+	assert.Equal([]string{"Numbers 28:1-3", "Numbers 28:3-5", "Numbers 28:6-10", "Numbers 28:11-15"},
+		aliyotStrings(l)[:4])
+	assert.Equal("Numbers 28:1-15", l.Summary)
+
+	l = holidayLeyning(t, hdate.New(5783, hdate.Adar1, 14), false)
+	assert.Equal("Exodus 17:8-16", l.Summary)
+	assert.Equal("Esther 1:1-10:3", l.Megillah)
+
+	// Tish'a B'Av 5782 was postponed to Sunday
+	tishaBav := event.HolidayEvent{Date: hdate.New(5782, hdate.Av, 10), Desc: "Tish'a B'Av (observed)"}
+	l, ok := leyning.GetLeyningForHoliday(tishaBav, false)
+	assert.True(ok)
+	assert.Equal("Deuteronomy 4:25-40", l.Summary)
+	assert.Equal("Jeremiah 8:13-9:23", l.Haftara)
+	assert.Equal("Lamentations 1:1-5:22", l.Megillah)
+	l, ok = leyning.GetMinchaLeyningForHoliday(tishaBav, false)
+	assert.True(ok)
+	assert.Equal("Exodus 32:11-14; 34:1-10", l.Summary)
+	assert.Equal("Isaiah 55:6-56:8", l.Haftara)
+
+	l = holidayLeyning(t, hdate.New(5783, hdate.Tishrei, 3), false)
+	assert.Equal("Tzom Gedaliah", l.Name)
+	assert.Equal("Exodus 32:11-14; 34:1-10", l.Summary)
+	assert.Equal("", l.Haftara)
+
+	yomKippur := event.HolidayEvent{Date: hdate.New(5783, hdate.Tishrei, 10), Desc: "Yom Kippur"}
+	l, ok = leyning.GetMinchaLeyningForHoliday(yomKippur, false)
+	assert.True(ok)
+	assert.Equal("Leviticus 18:1-30", l.Summary)
+	assert.Equal("Jonah 1:1-4:11; Micah 7:18-20", l.Haftara)
+
+	_, ok = leyning.GetMinchaLeyningForHoliday(event.HolidayEvent{Date: hdate.New(5783, hdate.Adar1, 14), Desc: "Purim"}, false)
+	assert.False(ok)
+	_, ok = leyning.GetLeyningForHolidayOnDate(hdate.New(5783, hdate.Shvat, 15), false)
+	assert.False(ok)
+}
+
+func TestHolidayReadingOnEveryChag(t *testing.T) {
+	assert := assert.New(t)
+	// every Shabbat on which sedra.Sedra has no parsha has a holiday reading
+	for year := 5783; year < 5783+19; year++ {
+		for _, il := range []bool{false, true} {
+			s := sedra.New(year, il)
+			start := hdate.New(year, hdate.Tishrei, 1).OnOrAfter(time.Saturday)
+			end := hdate.New(year+1, hdate.Tishrei, 1).Abs()
+			for abs := start.Abs(); abs < end; abs += 7 {
+				if !s.LookupByRD(abs).Chag {
+					continue
+				}
+				l, ok := leyning.GetLeyningForHolidayOnDate(hdate.FromRD(abs), il)
+				assert.True(ok, hdate.FromRD(abs).String())
+				assert.Equal(7, len(l.Aliyot), hdate.FromRD(abs).String())
+			}
+		}
+		// and so does every Yom Tov and Chol HaMoed day
+		for _, ev := range hebcal.GetHolidaysForYear(year, false) {
+			if (ev.Flags & (event.CHAG | event.CHOL_HAMOED)) != 0 {
+				_, ok := leyning.GetLeyningForHoliday(ev, false)
+				assert.True(ok, ev.Desc)
+			}
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Hebcal's leyning package provides the Torah and Haftara readings
+// (leyning) for the weekly parsha: the verses of each aliyah and the
+// maftir, and the Ashkenazic and Sephardic haftarot, adjusted for Rosh
+// Chodesh, Chanukah and the special Shabbatot. It also provides the
+// readings of the triennial cycle, in which each parsha is read over
+// three years, and the readings and megillot of the holidays.
+package leyning
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/sedra"
+)
+
+// Aliyah is a range of verses within one book of the Torah.
+type Aliyah struct {
+	Book  string // e.g. "Genesis"
+	Begin string // chapter and verse, e.g. "1:1"
+	End   string // chapter and verse, e.g. "2:3"
+}
+
+// parseAliyah parses a verse range of the form "1:1-2:3" or "2:4-19".
+func parseAliyah(book, verses string) Aliyah {
+	begin, end, _ := strings.Cut(verses, "-")
+	if !strings.Contains(end, ":") {
+		chapter, _, _ := strings.Cut(begin, ":")
+		end = chapter + ":" + end
+	}
+	return Aliyah{Book: book, Begin: begin, End: end}
+}
+
+// String returns the verse range, e.g. "Genesis 1:1-2:3", or
+// "Genesis 2:4-19" when it begins and ends in the same chapter.
+func (a Aliyah) String() string {
+	beginChapter, _, _ := strings.Cut(a.Begin, ":")
+	endChapter, endVerse, _ := strings.Cut(a.End, ":")
+	if beginChapter == endChapter {
+		return a.Book + " " + a.Begin + "-" + endVerse
+	}
+	return a.Book + " " + a.Begin + "-" + a.End
+}
+
+// Leyning is the Torah and Haftara reading for a Shabbat.
+type Leyning struct {
+	// Name of the parsha, e.g. "Bereshit" or "Vayakhel-Pekudei"
+	Name string
+	// Summary of the Torah reading, e.g. "Genesis 1:1-6:8"
+	Summary string
+	// The seven aliyot
+	Aliyot []Aliyah
+	Maftir Aliyah
+	// Ashkenazic haftara, e.g. "Isaiah 42:5-43:10"
+	Haftara string
+	// Sephardic haftara, or "" if it is the same as Haftara
+	HaftaraSephardic string
+	// Megillah read at Shacharit on some holidays, e.g. "Esther 1:1-10:3"
+	Megillah string
+	// Reason explains why part of the regular reading was replaced, keyed
+	// by "7" (the seventh aliyah), "maftir" or "haftara". It is nil when
+	// the regular reading is unchanged.
+	Reason map[string]string
+}
+
+// roshChodesh is the reading for Shabbat Rosh Chodesh.
+var roshChodesh = parseAliyah("Numbers", "28:9-15")
+
+const roshChodeshHaftara = "Isaiah 66:1-24"
+const macharChodeshHaftara = "I Samuel 20:18-42"
+
+// specialShabbat is the maftir and haftara of one of the special
+// Shabbatot. Book is empty for Shabbatot that change only the haftara.
+type specialShabbat struct {
+	book             string
+	maftir           string
+	haftara          string
+	haftaraSephardic string
+}
+
+var specialShabbatot = map[string]specialShabbat{
+	"Shabbat Shekalim":  {"Exodus", "30:11-16", "II Kings 12:1-17", "II Kings 11:17-12:17"},
+	"Shabbat Zachor":    {"Deuteronomy", "25:17-19", "I Samuel 15:2-34", "I Samuel 15:1-34"},
+	"Shabbat Parah":     {"Numbers", "19:1-22", "Ezekiel 36:16-38", "Ezekiel 36:16-36"},
+	"Shabbat HaChodesh": {"Exodus", "12:1-20", "Ezekiel 45:16-46:18", "Ezekiel 45:18-46:15"},
+	"Shabbat HaGadol":   {"", "", "Malachi 3:4-24", ""},
+	"Shabbat Shuva":     {"", "", "Hosea 14:2-10; Joel 2:15-27", "Hosea 14:2-10; Micah 7:18-20"},
+}
+
+// GetLeyningForParsha returns the regular Shabbat reading for p, without
+// any of the changes for Rosh Chodesh or special Shabbatot.
+func GetLeyningForParsha(p sedra.Parsha) (Leyning, error) {
+	if p.Chag {
+		return Leyning{}, fmt.Errorf("no parsha is read on a holiday")
+	}
+	name := strings.Join(p.Name, "-")
+	reading, ok := parshiyot[name]
+	if !ok {
+		return Leyning{}, fmt.Errorf("unknown parsha %q", name)
+	}
+	aliyot := make([]Aliyah, 7)
+	for i := range aliyot {
+		aliyot[i] = parseAliyah(reading.book, reading.aliyot[i])
+	}
+	summary := Aliyah{Book: reading.book, Begin: aliyot[0].Begin, End: aliyot[6].End}
+	return Leyning{
+		Name:             name,
+		Summary:          summary.String(),
+		Aliyot:           aliyot,
+		Maftir:           parseAliyah(reading.book, reading.aliyot[7]),
+		Haftara:          reading.haftara,
+		HaftaraSephardic: reading.haftaraSephardic,
+	}, nil
+}
+
+// GetLeyningOnDate returns the reading for the Shabbat hd, for either the
+// Diaspora (il=false) or Israel (il=true), with the maftir, haftara and
+// if necessary the seventh aliyah replaced on Shabbat Rosh Chodesh, Shabbat
+// Chanukah, the four special Shabbatot (Shekalim, Zachor, Parah and
+// HaChodesh), Shabbat HaGadol, Shabbat Shuva and Shabbat Machar Chodesh.
+//
+// It returns false if hd is not a Shabbat on which a parsha is read.
+func GetLeyningOnDate(hd hdate.HDate, il bool) (Leyning, bool) {
+	if hd.Weekday() != time.Saturday {
+		return Leyning{}, false
+	}
+	s := sedra.New(hd.Year(), il)
+	parsha := s.LookupByRD(hd.Abs())
+	if parsha.Chag {
+		return Leyning{}, false
+	}
+	l, err := GetLeyningForParsha(parsha)
+	if err != nil {
+		return Leyning{}, false
+	}
+	applySpecialShabbat(&l, hd, il)
+	return l, true
+}
+
+// applySpecialShabbat replaces parts of the regular reading l on hd.
+func applySpecialShabbat(l *Leyning, hd hdate.HDate, il bool) {
+	isRoshChodesh := false
+	chanukahDay := 0
+	special := ""
+	for _, ev := range hebcal.GetHolidaysOnDate(hd, il) {
+		switch {
+		case (ev.Flags & event.ROSH_CHODESH) != 0:
+			isRoshChodesh = true
+		case ev.ChanukahDay > 0:
+			chanukahDay = ev.ChanukahDay
+		case (ev.Flags & event.SPECIAL_SHABBAT) != 0:
+			if _, ok := specialShabbatot[ev.Desc]; ok {
+				special = ev.Desc
+			}
+		}
+	}
+	month := hd.Month()
+	if l.Name == "Pinchas" && hd.Abs() > hdate.New(hd.Year(), hdate.Tamuz, 17).Abs() {
+		l.setHaftara("Jeremiah 1:1-2:3", "", "Pinchas occurring after 17 Tammuz")
+	}
+	if isRoshChodesh {
+		l.setMaftir(roshChodesh, "Shabbat Rosh Chodesh")
+		// During the Three Weeks and the Seven of Consolation, the
+		// haftara of the season is read instead.
+		if month != hdate.Av && month != hdate.Elul {
+			l.setHaftara(roshChodeshHaftara, "", "Shabbat Rosh Chodesh")
+		}
+	}
+	haftaraReplaced := isRoshChodesh
+	if chanukahDay > 0 {
+		if isRoshChodesh {
+			l.setSeventh(roshChodesh, "Shabbat Rosh Chodesh")
+		}
+		l.setMaftir(chanukahReading(chanukahDay), "Shabbat Chanukah")
+		if chanukahDay == 8 {
+			l.setHaftara("I Kings 7:40-50", "", "Shabbat Chanukah II")
+		} else {
+			l.setHaftara("Zechariah 2:14-4:7", "", "Shabbat Chanukah")
+		}
+		haftaraReplaced = true
+	}
+	if special != "" {
+		sp := specialShabbatot[special]
+		if sp.book != "" {
+			if isRoshChodesh {
+				l.setSeventh(roshChodesh, "Shabbat Rosh Chodesh")
+			}
+			l.setMaftir(parseAliyah(sp.book, sp.maftir), special)
+		}
+		l.setHaftara(sp.haftara, sp.haftaraSephardic, special)
+		haftaraReplaced = true
+	}
+	if !haftaraReplaced {
+		tomorrow := hd.Next()
+		switch tomorrow.Month() {
+		case hdate.Tishrei, hdate.Av, hdate.Elul:
+		default:
+			if tomorrow.Day() == 1 || tomorrow.Day() == 30 {
+				l.setHaftara(macharChodeshHaftara, "", "Shabbat Machar Chodesh")
+			}
+		}
+	}
+}
+
+// chanukahReading returns the reading for day (1-8) of Chanukah: the
+// offerings of the princes in Numbers 7.
+func chanukahReading(day int) Aliyah {
+	switch day {
+	case 1:
+		return parseAliyah("Numbers", "7:1-17")
+	case 8:
+		return parseAliyah("Numbers", "7:54-8:4")
+	}
+	begin := 18 + 6*(day-2)
+	return parseAliyah("Numbers", "7:"+strconv.Itoa(begin)+"-"+strconv.Itoa(begin+5))
+}
+
+func (l *Leyning) setReason(key, reason string) {
+	if l.Reason == nil {
+		l.Reason = make(map[string]string)
+	}
+	l.Reason[key] = reason
+}
+
+func (l *Leyning) setMaftir(a Aliyah, reason string) {
+	l.Maftir = a
+	l.setReason("maftir", reason)
+}
+
+func (l *Leyning) setHaftara(haftara, sephardic, reason string) {
+	l.Haftara = haftara
+	l.HaftaraSephardic = sephardic
+	l.setReason("haftara", reason)
+}
+
+// setSeventh replaces the seventh aliyah with a, extending the sixth
+// aliyah to the end of the parsha.
+func (l *Leyning) setSeventh(a Aliyah, reason string) {
+	l.Aliyot[5].End = l.Aliyot[6].End
+	l.Aliyot[6] = a
+	l.setReason("7", reason)
+}
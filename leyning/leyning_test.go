@@ -0,0 +1,124 @@
+package leyning_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/leyning"
+	"github.com/hebcal/hebcal-go/sedra"
+)
+
+func aliyotStrings(l leyning.Leyning) []string {
+	result := make([]string, 0, len(l.Aliyot)+1)
+	for _, a := range l.Aliyot {
+		result = append(result, a.String())
+	}
+	return append(result, l.Maftir.String())
+}
+
+func TestGetLeyningForParsha(t *testing.T) {
+	assert := assert.New(t)
+	l, err := leyning.GetLeyningForParsha(sedra.Parsha{Name: []string{"Bereshit"}, Num: []int{1}})
+	assert.NoError(err)
+	assert.Equal("Bereshit", l.Name)
+	assert.Equal("Genesis 1:1-6:8", l.Summary)
+	assert.Equal([]string{
+		"Genesis 1:1-2:3",
+		"Genesis 2:4-19",
+		"Genesis 2:20-3:21",
+		"Genesis 3:22-4:18",
+		"Genesis 4:19-22",
+		"Genesis 4:23-5:24",
+		"Genesis 5:25-6:8",
+		"Genesis 6:5-8",
+	}, aliyotStrings(l))
+	assert.Equal("Isaiah 42:5-43:10", l.Haftara)
+	assert.Equal("Isaiah 42:5-21", l.HaftaraSephardic)
+	assert.Nil(l.Reason)
+
+	l, err = leyning.GetLeyningForParsha(sedra.Parsha{Name: []string{"Matot", "Masei"}, Num: []int{42, 43}})
+	assert.NoError(err)
+	assert.Equal("Numbers 30:2-36:13", l.Summary)
+	assert.Equal("Jeremiah 2:4-28; 3:4", l.Haftara)
+	assert.Equal("Jeremiah 2:4-28; 4:1-2", l.HaftaraSephardic)
+
+	_, err = leyning.GetLeyningForParsha(sedra.Parsha{Chag: true})
+	assert.Error(err)
+	_, err = leyning.GetLeyningForParsha(sedra.Parsha{Name: []string{"Noach", "Lech-Lecha"}})
+	assert.Error(err)
+}
+
+func TestAllParshiyot(t *testing.T) {
+	assert := assert.New(t)
+	// every Shabbat of a 19-year cycle, in both Israel and the Diaspora,
+	// has a reading, and every reading has seven aliyot within one book
+	for year := 5783; year < 5783+19; year++ {
+		for _, il := range []bool{false, true} {
+			s := sedra.New(year, il)
+			start := hdate.New(year, hdate.Tishrei, 1).OnOrAfter(time.Saturday)
+			end := hdate.New(year+1, hdate.Tishrei, 1).Abs()
+			for abs := start.Abs(); abs < end; abs += 7 {
+				parsha := s.LookupByRD(abs)
+				l, ok := leyning.GetLeyningOnDate(hdate.FromRD(abs), il)
+				assert.Equal(!parsha.Chag, ok, hdate.FromRD(abs).String())
+				if ok {
+					assert.Equal(7, len(l.Aliyot))
+					assert.NotEqual("", l.Haftara)
+				}
+			}
+		}
+	}
+}
+
+func TestGetLeyningOnDateSpecial(t *testing.T) {
+	assert := assert.New(t)
+	// Miketz on Shabbat Chanukah and Rosh Chodesh Tevet
+	l, ok := leyning.GetLeyningOnDate(hdate.New(5783, hdate.Kislev, 30), false)
+	assert.True(ok)
+	assert.Equal("Miketz", l.Name)
+	assert.Equal("Genesis 43:16-44:17", l.Aliyot[5].String())
+	assert.Equal("Numbers 28:9-15", l.Aliyot[6].String())
+	assert.Equal("Numbers 7:42-47", l.Maftir.String())
+	assert.Equal("Zechariah 2:14-4:7", l.Haftara)
+	assert.Equal(map[string]string{
+		"7":       "Shabbat Rosh Chodesh",
+		"maftir":  "Shabbat Chanukah",
+		"haftara": "Shabbat Chanukah",
+	}, l.Reason)
+
+	// Shabbat Zachor
+	l, ok = leyning.GetLeyningOnDate(hdate.New(5783, hdate.Adar1, 11), false)
+	assert.True(ok)
+	assert.Equal("Tetzaveh", l.Name)
+	assert.Equal("Deuteronomy 25:17-19", l.Maftir.String())
+	assert.Equal("I Samuel 15:2-34", l.Haftara)
+	assert.Equal("I Samuel 15:1-34", l.HaftaraSephardic)
+
+	// Shabbat Rosh Chodesh Iyyar
+	l, ok = leyning.GetLeyningOnDate(hdate.New(5783, hdate.Iyyar, 1), false)
+	assert.True(ok)
+	assert.Equal("Tazria-Metzora", l.Name)
+	assert.Equal("Numbers 28:9-15", l.Maftir.String())
+	assert.Equal("Isaiah 66:1-24", l.Haftara)
+
+	// Shabbat Machar Chodesh
+	l, ok = leyning.GetLeyningOnDate(hdate.New(5783, hdate.Iyyar, 29), false)
+	assert.True(ok)
+	assert.Equal("Bamidbar", l.Name)
+	assert.Equal("I Samuel 20:18-42", l.Haftara)
+
+	// Pinchas after 17 Tammuz
+	l, ok = leyning.GetLeyningOnDate(hdate.New(5783, hdate.Tamuz, 19), false)
+	assert.True(ok)
+	assert.Equal("Jeremiah 1:1-2:3", l.Haftara)
+
+	// not a Shabbat
+	_, ok = leyning.GetLeyningOnDate(hdate.New(5783, hdate.Tamuz, 20), false)
+	assert.False(ok)
+	// Shabbat Chol HaMoed Sukkot
+	_, ok = leyning.GetLeyningOnDate(hdate.FromGregorian(2022, 10, 15), false)
+	assert.False(ok)
+}
@@ -0,0 +1,216 @@
+package leyning
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// parshaReading is the regular Shabbat reading of a parsha. Verse ranges
+// are within book; the eighth entry of aliyot is the maftir. The Sephardic
+// haftara is empty when it is the same as the Ashkenazic one.
+type parshaReading struct {
+	book             string
+	aliyot           [8]string
+	haftara          string
+	haftaraSephardic string
+}
+
+// parshiyot is keyed by the parsha name as it appears in sedra.Parsha.Name,
+// or by both names joined with a hyphen for the doubled parshiyot.
+var parshiyot = map[string]parshaReading{
+	"Bereshit": {"Genesis",
+		[8]string{"1:1-2:3", "2:4-19", "2:20-3:21", "3:22-4:18", "4:19-22", "4:23-5:24", "5:25-6:8", "6:5-8"},
+		"Isaiah 42:5-43:10", "Isaiah 42:5-21"},
+	"Noach": {"Genesis",
+		[8]string{"6:9-22", "7:1-16", "7:17-8:14", "8:15-9:7", "9:8-17", "9:18-10:32", "11:1-32", "11:29-32"},
+		"Isaiah 54:1-55:5", "Isaiah 54:1-10"},
+	"Lech-Lecha": {"Genesis",
+		[8]string{"12:1-13", "12:14-13:4", "13:5-18", "14:1-20", "14:21-15:6", "15:7-17:6", "17:7-27", "17:24-27"},
+		"Isaiah 40:27-41:16", ""},
+	"Vayera": {"Genesis",
+		[8]string{"18:1-14", "18:15-33", "19:1-20", "19:21-21:4", "21:5-21", "21:22-34", "22:1-24", "22:20-24"},
+		"II Kings 4:1-37", "II Kings 4:1-23"},
+	"Chayei Sara": {"Genesis",
+		[8]string{"23:1-16", "23:17-24:9", "24:10-26", "24:27-52", "24:53-67", "25:1-11", "25:12-18", "25:16-18"},
+		"I Kings 1:1-31", ""},
+	"Toldot": {"Genesis",
+		[8]string{"25:19-26:5", "26:6-12", "26:13-22", "26:23-29", "26:30-27:27", "27:28-28:4", "28:5-9", "28:7-9"},
+		"Malachi 1:1-2:7", ""},
+	"Vayetzei": {"Genesis",
+		[8]string{"28:10-22", "29:1-17", "29:18-30:13", "30:14-27", "30:28-31:16", "31:17-42", "31:43-32:3", "32:1-3"},
+		"Hosea 12:13-14:10", "Hosea 11:7-12:12"},
+	"Vayishlach": {"Genesis",
+		[8]string{"32:4-13", "32:14-30", "32:31-33:5", "33:6-20", "34:1-35:11", "35:12-36:19", "36:20-43", "36:40-43"},
+		"Obadiah 1:1-21", ""},
+	"Vayeshev": {"Genesis",
+		[8]string{"37:1-11", "37:12-22", "37:23-36", "38:1-30", "39:1-6", "39:7-23", "40:1-23", "40:20-23"},
+		"Amos 2:6-3:8", ""},
+	"Miketz": {"Genesis",
+		[8]string{"41:1-14", "41:15-38", "41:39-52", "41:53-42:18", "42:19-43:15", "43:16-29", "43:30-44:17", "44:14-17"},
+		"I Kings 3:15-4:1", ""},
+	"Vayigash": {"Genesis",
+		[8]string{"44:18-30", "44:31-45:7", "45:8-18", "45:19-27", "45:28-46:27", "46:28-47:10", "47:11-27", "47:25-27"},
+		"Ezekiel 37:15-28", ""},
+	"Vayechi": {"Genesis",
+		[8]string{"47:28-48:9", "48:10-16", "48:17-22", "49:1-18", "49:19-26", "49:27-50:20", "50:21-26", "50:23-26"},
+		"I Kings 2:1-12", ""},
+
+	"Shemot": {"Exodus",
+		[8]string{"1:1-17", "1:18-2:10", "2:11-25", "3:1-15", "3:16-4:17", "4:18-31", "5:1-6:1", "5:22-6:1"},
+		"Isaiah 27:6-28:13; 29:22-23", "Jeremiah 1:1-2:3"},
+	"Vaera": {"Exodus",
+		[8]string{"6:2-13", "6:14-28", "6:29-7:7", "7:8-8:6", "8:7-18", "8:19-9:16", "9:17-35", "9:33-35"},
+		"Ezekiel 28:25-29:21", ""},
+	"Bo": {"Exodus",
+		[8]string{"10:1-11", "10:12-23", "10:24-11:3", "11:4-12:20", "12:21-28", "12:29-51", "13:1-16", "13:14-16"},
+		"Jeremiah 46:13-28", ""},
+	"Beshalach": {"Exodus",
+		[8]string{"13:17-14:8", "14:9-14", "14:15-25", "14:26-15:26", "15:27-16:10", "16:11-36", "17:1-16", "17:14-16"},
+		"Judges 4:4-5:31", "Judges 5:1-31"},
+	"Yitro": {"Exodus",
+		[8]string{"18:1-12", "18:13-23", "18:24-27", "19:1-6", "19:7-19", "19:20-20:14", "20:15-23", "20:19-23"},
+		"Isaiah 6:1-7:6; 9:5-6", "Isaiah 6:1-13"},
+	"Mishpatim": {"Exodus",
+		[8]string{"21:1-19", "21:20-22:3", "22:4-26", "22:27-23:5", "23:6-19", "23:20-25", "23:26-24:18", "24:15-18"},
+		"Jeremiah 34:8-22; 33:25-26", ""},
+	"Terumah": {"Exodus",
+		[8]string{"25:1-16", "25:17-30", "25:31-26:14", "26:15-30", "26:31-37", "27:1-8", "27:9-19", "27:17-19"},
+		"I Kings 5:26-6:13", ""},
+	"Tetzaveh": {"Exodus",
+		[8]string{"27:20-28:12", "28:13-30", "28:31-43", "29:1-18", "29:19-37", "29:38-46", "30:1-10", "30:8-10"},
+		"Ezekiel 43:10-27", ""},
+	"Ki Tisa": {"Exodus",
+		[8]string{"30:11-31:17", "31:18-33:11", "33:12-16", "33:17-23", "34:1-9", "34:10-26", "34:27-35", "34:33-35"},
+		"I Kings 18:1-39", "I Kings 18:20-39"},
+	"Vayakhel": {"Exodus",
+		[8]string{"35:1-20", "35:21-29", "35:30-36:7", "36:8-19", "36:20-37:16", "37:17-29", "38:1-20", "38:18-20"},
+		"I Kings 7:40-50", "I Kings 7:13-26"},
+	"Pekudei": {"Exodus",
+		[8]string{"38:21-39:1", "39:2-21", "39:22-32", "39:33-43", "40:1-16", "40:17-27", "40:28-38", "40:34-38"},
+		"I Kings 7:51-8:21", "I Kings 7:40-50"},
+	"Vayakhel-Pekudei": {"Exodus",
+		[8]string{"35:1-20", "35:21-29", "35:30-37:16", "37:17-29", "38:1-39:1", "39:2-21", "39:22-40:38", "40:34-38"},
+		"I Kings 7:51-8:21", "I Kings 7:40-50"},
+
+	"Vayikra": {"Leviticus",
+		[8]string{"1:1-13", "1:14-2:6", "2:7-16", "3:1-17", "4:1-26", "4:27-5:10", "5:11-26", "5:24-26"},
+		"Isaiah 43:21-44:23", ""},
+	"Tzav": {"Leviticus",
+		[8]string{"6:1-11", "6:12-7:10", "7:11-38", "8:1-13", "8:14-21", "8:22-29", "8:30-36", "8:33-36"},
+		"Jeremiah 7:21-8:3; 9:22-23", ""},
+	"Shmini": {"Leviticus",
+		[8]string{"9:1-16", "9:17-23", "9:24-10:11", "10:12-15", "10:16-20", "11:1-32", "11:33-47", "11:45-47"},
+		"II Samuel 6:1-7:17", "II Samuel 6:1-19"},
+	"Tazria": {"Leviticus",
+		[8]string{"12:1-13:5", "13:6-17", "13:18-23", "13:24-28", "13:29-39", "13:40-54", "13:55-59", "13:56-59"},
+		"II Kings 4:42-5:19", ""},
+	"Metzora": {"Leviticus",
+		[8]string{"14:1-12", "14:13-20", "14:21-32", "14:33-53", "14:54-15:15", "15:16-28", "15:29-33", "15:31-33"},
+		"II Kings 7:3-20", ""},
+	"Tazria-Metzora": {"Leviticus",
+		[8]string{"12:1-13:23", "13:24-39", "13:40-54", "13:55-14:20", "14:21-32", "14:33-15:15", "15:16-33", "15:31-33"},
+		"II Kings 7:3-20", ""},
+	"Achrei Mot": {"Leviticus",
+		[8]string{"16:1-17", "16:18-24", "16:25-34", "17:1-7", "17:8-18:5", "18:6-21", "18:22-30", "18:28-30"},
+		"Ezekiel 22:1-19", "Ezekiel 22:1-16"},
+	"Kedoshim": {"Leviticus",
+		[8]string{"19:1-14", "19:15-22", "19:23-32", "19:33-37", "20:1-7", "20:8-22", "20:23-27", "20:25-27"},
+		"Amos 9:7-15", "Ezekiel 20:2-20"},
+	"Achrei Mot-Kedoshim": {"Leviticus",
+		[8]string{"16:1-24", "16:25-17:7", "17:8-18:21", "18:22-19:14", "19:15-32", "19:33-20:7", "20:8-27", "20:25-27"},
+		"Amos 9:7-15", "Ezekiel 20:2-20"},
+	"Emor": {"Leviticus",
+		[8]string{"21:1-15", "21:16-22:16", "22:17-33", "23:1-22", "23:23-32", "23:33-44", "24:1-23", "24:21-23"},
+		"Ezekiel 44:15-31", ""},
+	"Behar": {"Leviticus",
+		[8]string{"25:1-13", "25:14-18", "25:19-24", "25:25-28", "25:29-38", "25:39-46", "25:47-26:2", "25:55-26:2"},
+		"Jeremiah 32:6-27", ""},
+	"Bechukotai": {"Leviticus",
+		[8]string{"26:3-5", "26:6-9", "26:10-46", "27:1-15", "27:16-21", "27:22-28", "27:29-34", "27:32-34"},
+		"Jeremiah 16:19-17:14", ""},
+	"Behar-Bechukotai": {"Leviticus",
+		[8]string{"25:1-28", "25:29-43", "25:44-26:9", "26:10-46", "27:1-15", "27:16-21", "27:22-34", "27:32-34"},
+		"Jeremiah 16:19-17:14", ""},
+
+	"Bamidbar": {"Numbers",
+		[8]string{"1:1-19", "1:20-54", "2:1-34", "3:1-13", "3:14-39", "3:40-51", "4:1-20", "4:17-20"},
+		"Hosea 2:1-22", ""},
+	"Nasso": {"Numbers",
+		[8]string{"4:21-37", "4:38-49", "5:1-10", "5:11-6:27", "7:1-41", "7:42-71", "7:72-89", "7:87-89"},
+		"Judges 13:2-25", ""},
+	"Beha'alotcha": {"Numbers",
+		[8]string{"8:1-14", "8:15-26", "9:1-14", "9:15-10:10", "10:11-34", "10:35-11:29", "11:30-12:16", "12:14-16"},
+		"Zechariah 2:14-4:7", ""},
+	"Sh'lach": {"Numbers",
+		[8]string{"13:1-20", "13:21-14:7", "14:8-25", "14:26-15:7", "15:8-16", "15:17-26", "15:27-41", "15:37-41"},
+		"Joshua 2:1-24", ""},
+	"Korach": {"Numbers",
+		[8]string{"16:1-13", "16:14-19", "16:20-17:8", "17:9-15", "17:16-24", "17:25-18:20", "18:21-32", "18:30-32"},
+		"I Samuel 11:14-12:22", ""},
+	"Chukat": {"Numbers",
+		[8]string{"19:1-17", "19:18-20:6", "20:7-13", "20:14-21", "20:22-21:9", "21:10-20", "21:21-22:1", "21:34-22:1"},
+		"Judges 11:1-33", ""},
+	"Balak": {"Numbers",
+		[8]string{"22:2-12", "22:13-20", "22:21-38", "22:39-23:12", "23:13-26", "23:27-24:13", "24:14-25:9", "25:7-9"},
+		"Micah 5:6-6:8", ""},
+	"Chukat-Balak": {"Numbers",
+		[8]string{"19:1-20:6", "20:7-21:9", "21:10-22:1", "22:2-38", "22:39-23:12", "23:13-26", "23:27-25:9", "25:7-9"},
+		"Micah 5:6-6:8", ""},
+	"Pinchas": {"Numbers",
+		[8]string{"25:10-26:4", "26:5-51", "26:52-27:5", "27:6-23", "28:1-15", "28:16-29:11", "29:12-30:1", "29:35-30:1"},
+		"I Kings 18:46-19:21", ""},
+	"Matot": {"Numbers",
+		[8]string{"30:2-17", "31:1-12", "31:13-24", "31:25-41", "31:42-54", "32:1-19", "32:20-42", "32:39-42"},
+		"Jeremiah 1:1-2:3", ""},
+	"Masei": {"Numbers",
+		[8]string{"33:1-10", "33:11-49", "33:50-34:15", "34:16-29", "35:1-8", "35:9-34", "36:1-13", "36:11-13"},
+		"Jeremiah 2:4-28; 3:4", "Jeremiah 2:4-28; 4:1-2"},
+	"Matot-Masei": {"Numbers",
+		[8]string{"30:2-31:12", "31:13-41", "31:42-32:19", "32:20-33:10", "33:11-49", "33:50-34:15", "34:16-36:13", "36:11-13"},
+		"Jeremiah 2:4-28; 3:4", "Jeremiah 2:4-28; 4:1-2"},
+
+	"Devarim": {"Deuteronomy",
+		[8]string{"1:1-10", "1:11-21", "1:22-38", "1:39-2:1", "2:2-30", "2:31-3:14", "3:15-22", "3:20-22"},
+		"Isaiah 1:1-27", ""},
+	"Vaetchanan": {"Deuteronomy",
+		[8]string{"3:23-4:4", "4:5-40", "4:41-49", "5:1-18", "5:19-6:3", "6:4-25", "7:1-11", "7:9-11"},
+		"Isaiah 40:1-26", ""},
+	"Eikev": {"Deuteronomy",
+		[8]string{"7:12-8:10", "8:11-9:3", "9:4-29", "10:1-11", "10:12-11:9", "11:10-21", "11:22-25", "11:22-25"},
+		"Isaiah 49:14-51:3", ""},
+	"Re'eh": {"Deuteronomy",
+		[8]string{"11:26-12:10", "12:11-28", "12:29-13:19", "14:1-21", "14:22-29", "15:1-18", "15:19-16:17", "16:13-17"},
+		"Isaiah 54:11-55:5", ""},
+	"Shoftim": {"Deuteronomy",
+		[8]string{"16:18-17:13", "17:14-20", "18:1-5", "18:6-13", "18:14-19:13", "19:14-20:9", "20:10-21:9", "21:7-9"},
+		"Isaiah 51:12-52:12", ""},
+	"Ki Teitzei": {"Deuteronomy",
+		[8]string{"21:10-21", "21:22-22:7", "22:8-23:7", "23:8-24", "23:25-24:4", "24:5-13", "24:14-25:19", "25:17-19"},
+		"Isaiah 54:1-10", ""},
+	"Ki Tavo": {"Deuteronomy",
+		[8]string{"26:1-11", "26:12-15", "26:16-19", "27:1-10", "27:11-28:6", "28:7-69", "29:1-8", "29:6-8"},
+		"Isaiah 60:1-22", ""},
+	"Nitzavim": {"Deuteronomy",
+		[8]string{"29:9-11", "29:12-14", "29:15-28", "30:1-6", "30:7-10", "30:11-14", "30:15-20", "30:15-20"},
+		"Isaiah 61:10-63:9", ""},
+	"Vayeilech": {"Deuteronomy",
+		[8]string{"31:1-3", "31:4-6", "31:7-9", "31:10-13", "31:14-19", "31:20-24", "31:25-30", "31:28-30"},
+		"Isaiah 55:6-56:8", ""},
+	"Nitzavim-Vayeilech": {"Deuteronomy",
+		[8]string{"29:9-28", "30:1-6", "30:7-14", "30:15-31:3", "31:4-6", "31:7-13", "31:14-30", "31:28-30"},
+		"Isaiah 61:10-63:9", ""},
+	"Ha'azinu": {"Deuteronomy",
+		[8]string{"32:1-6", "32:7-12", "32:13-18", "32:19-28", "32:29-39", "32:40-43", "32:44-52", "32:48-52"},
+		"II Samuel 22:1-51", ""},
+}
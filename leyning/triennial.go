@@ -0,0 +1,110 @@
+package leyning
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/sedra"
+)
+
+// triennialCycleStart is the first year of a triennial cycle; a new cycle
+// begins every three years after it.
+const triennialCycleStart = 5744
+
+// maftirVerses is the length of the maftir, which repeats the end of the
+// triennial reading.
+const maftirVerses = 3
+
+// TriennialCycleYear returns the position (1, 2 or 3) of Hebrew year year
+// in the triennial cycle. Cycles begin with Bereshit in 5744, 5747, ...,
+// 5786.
+func TriennialCycleYear(year int) int {
+	n := (year - triennialCycleStart) % 3
+	if n < 0 {
+		n += 3
+	}
+	return n + 1
+}
+
+// GetTriennialForParsha returns the triennial reading for p in year
+// cycleYear (1, 2 or 3) of the triennial cycle, without any of the changes
+// for Rosh Chodesh or special Shabbatot. The maftir repeats the last three
+// verses of the seventh aliyah.
+//
+// A doubled parsha is read in each year of the cycle as the portions of
+// that year of both of its parshiyot, so that a parsha read separately in
+// one year of the cycle and doubled in another is still read in full over
+// the three years.
+func GetTriennialForParsha(p sedra.Parsha, cycleYear int) (Leyning, error) {
+	if p.Chag {
+		return Leyning{}, fmt.Errorf("no parsha is read on a holiday")
+	}
+	if cycleYear < 1 || cycleYear > 3 {
+		return Leyning{}, fmt.Errorf("invalid triennial cycle year %d", cycleYear)
+	}
+	name := strings.Join(p.Name, "-")
+	reading, ok := parshiyot[name]
+	if !ok {
+		return Leyning{}, fmt.Errorf("unknown parsha %q", name)
+	}
+	triennial, ok := triennialAliyot[name]
+	if !ok {
+		return Leyning{}, fmt.Errorf("unknown parsha %q", name)
+	}
+	aliyot := make([]Aliyah, 7)
+	for i, s := range triennial[cycleYear-1] {
+		aliyot[i] = parseAliyah(reading.book, s)
+	}
+	maftir := aliyot[6].verses()
+	if len(maftir) > maftirVerses {
+		maftir = maftir[len(maftir)-maftirVerses:]
+	}
+	return Leyning{
+		Name:             name,
+		Summary:          summarize(aliyot),
+		Aliyot:           aliyot,
+		Maftir:           versesToAliyah(reading.book, maftir),
+		Haftara:          reading.haftara,
+		HaftaraSephardic: reading.haftaraSephardic,
+	}, nil
+}
+
+// GetTriennialOnDate returns the triennial reading for the Shabbat hd, for
+// either the Diaspora (il=false) or Israel (il=true), with the same changes
+// for Rosh Chodesh, Chanukah and the special Shabbatot as GetLeyningOnDate.
+//
+// It returns false if hd is not a Shabbat on which a parsha is read.
+func GetTriennialOnDate(hd hdate.HDate, il bool) (Leyning, bool) {
+	if hd.Weekday() != time.Saturday {
+		return Leyning{}, false
+	}
+	s := sedra.New(hd.Year(), il)
+	parsha := s.LookupByRD(hd.Abs())
+	if parsha.Chag {
+		return Leyning{}, false
+	}
+	l, err := GetTriennialForParsha(parsha, TriennialCycleYear(hd.Year()))
+	if err != nil {
+		return Leyning{}, false
+	}
+	applySpecialShabbat(&l, hd, il)
+	return l, true
+}
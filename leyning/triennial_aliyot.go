@@ -0,0 +1,329 @@
+package leyning
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// triennialAliyot holds the seven aliyot read in each year of the
+// triennial cycle, keyed like parshiyot, in the notation of
+// parshaReading.aliyot. Over the three years each parsha is read from
+// beginning to end, except that Nitzavim, Vayeilech and Ha'azinu are read
+// in full every year.
+//
+// A doubled parsha reads in each year the portions of that year of both of
+// its parshiyot, so that a parsha read separately in some years of the
+// cycle and doubled in others is still read in full over the three years.
+var triennialAliyot = map[string][3][7]string{
+	"Bereshit": {
+		{"1:1-5", "1:6-8", "1:9-13", "1:14-19", "1:20-23", "1:24-31", "2:1-3"},
+		{"2:4-9", "2:10-14", "2:15-17", "2:18-25", "3:1-7", "3:8-15", "3:16-21"},
+		{"3:22-24", "4:1-18", "4:19-22", "4:23-26", "5:1-24", "5:25-32", "6:1-8"},
+	},
+	"Noach": {
+		{"6:9-16", "6:17-19", "6:20-22", "7:1-9", "7:10-16", "7:17-24", "8:1-14"},
+		{"8:15-22", "9:1-7", "9:8-17", "9:18-29", "10:1-14", "10:15-25", "10:26-32"},
+		{"11:1-4", "11:5-9", "11:10-13", "11:14-17", "11:18-21", "11:22-25", "11:26-32"},
+	},
+	"Lech-Lecha": {
+		{"12:1-3", "12:4-9", "12:10-13", "12:14-20", "13:1-4", "13:5-11", "13:12-18"},
+		{"14:1-9", "14:10-16", "14:17-20", "14:21-24", "15:1-6", "15:7-16", "15:17-21"},
+		{"16:1-6", "16:7-9", "16:10-16", "17:1-6", "17:7-17", "17:18-23", "17:24-27"},
+	},
+	"Vayera": {
+		{"18:1-5", "18:6-8", "18:9-14", "18:15-21", "18:22-26", "18:27-30", "18:31-33"},
+		{"19:1-11", "19:12-20", "19:21-29", "19:30-38", "20:1-8", "20:9-14", "20:15-18"},
+		{"21:1-4", "21:5-13", "21:14-21", "21:22-34", "22:1-8", "22:9-19", "22:20-24"},
+	},
+	"Chayei Sara": {
+		{"23:1-4", "23:5-7", "23:8-12", "23:13-16", "23:17-20", "24:1-4", "24:5-9"},
+		{"24:10-14", "24:15-20", "24:21-26", "24:27-33", "24:34-41", "24:42-49", "24:50-52"},
+		{"24:53-58", "24:59-61", "24:62-67", "25:1-6", "25:7-11", "25:12-15", "25:16-18"},
+	},
+	"Toldot": {
+		{"25:19-22", "25:23-26", "25:27-34", "26:1-5", "26:6-12", "26:13-16", "26:17-22"},
+		{"26:23-29", "26:30-35", "27:1-4", "27:5-13", "27:14-17", "27:18-23", "27:24-27"},
+		{"27:28-30", "27:31-33", "27:34-37", "27:38-40", "27:41-46", "28:1-4", "28:5-9"},
+	},
+	"Vayetzei": {
+		{"28:10-12", "28:13-17", "28:18-22", "29:1-8", "29:9-17", "29:18-33", "29:34-30:13"},
+		{"30:14-16", "30:17-21", "30:22-27", "30:28-36", "30:37-43", "31:1-9", "31:10-16"},
+		{"31:17-21", "31:22-24", "31:25-35", "31:36-42", "31:43-45", "31:46-50", "31:51-32:3"},
+	},
+	"Vayishlach": {
+		{"32:4-6", "32:7-9", "32:10-13", "32:14-22", "32:23-30", "32:31-33", "33:1-5"},
+		{"33:6-11", "33:12-14", "33:15-17", "33:18-20", "34:1-12", "34:13-17", "34:18-31"},
+		{"35:1-4", "35:5-8", "35:9-15", "35:16-22", "35:23-29", "36:1-19", "36:20-43"},
+	},
+	"Vayeshev": {
+		{"37:1-3", "37:4-7", "37:8-11", "37:12-17", "37:18-22", "37:23-28", "37:29-36"},
+		{"38:1-5", "38:6-11", "38:12-14", "38:15-19", "38:20-23", "38:24-26", "38:27-30"},
+		{"39:1-6", "39:7-10", "39:11-18", "39:19-23", "40:1-8", "40:9-15", "40:16-23"},
+	},
+	"Miketz": {
+		{"41:1-4", "41:5-7", "41:8-14", "41:15-24", "41:25-38", "41:39-43", "41:44-52"},
+		{"41:53-57", "42:1-5", "42:6-18", "42:19-28", "42:29-38", "43:1-7", "43:8-15"},
+		{"43:16-18", "43:19-25", "43:26-29", "43:30-34", "44:1-6", "44:7-10", "44:11-17"},
+	},
+	"Vayigash": {
+		{"44:18-20", "44:21-24", "44:25-30", "44:31-34", "45:1-7", "45:8-18", "45:19-27"},
+		{"45:28-46:4", "46:5-7", "46:8-11", "46:12-15", "46:16-18", "46:19-22", "46:23-27"},
+		{"46:28-30", "46:31-34", "47:1-6", "47:7-10", "47:11-19", "47:20-22", "47:23-27"},
+	},
+	"Vayechi": {
+		{"47:28-31", "48:1-3", "48:4-9", "48:10-13", "48:14-16", "48:17-19", "48:20-22"},
+		{"49:1-4", "49:5-7", "49:8-12", "49:13-15", "49:16-18", "49:19-21", "49:22-26"},
+		{"49:27-30", "49:31-33", "50:1-6", "50:7-9", "50:10-14", "50:15-20", "50:21-26"},
+	},
+	"Shemot": {
+		{"1:1-7", "1:8-12", "1:13-17", "1:18-22", "2:1-10", "2:11-15", "2:16-25"},
+		{"3:1-6", "3:7-10", "3:11-15", "3:16-22", "4:1-5", "4:6-9", "4:10-17"},
+		{"4:18-20", "4:21-26", "4:27-31", "5:1-5", "5:6-9", "5:10-14", "5:15-6:1"},
+	},
+	"Vaera": {
+		{"6:2-5", "6:6-9", "6:10-13", "6:14-19", "6:20-25", "6:26-28", "6:29-7:7"},
+		{"7:8-13", "7:14-18", "7:19-25", "7:26-29", "8:1-6", "8:7-11", "8:12-18"},
+		{"8:19-22", "8:23-28", "9:1-7", "9:8-12", "9:13-16", "9:17-26", "9:27-35"},
+	},
+	"Bo": {
+		{"10:1-3", "10:4-6", "10:7-11", "10:12-15", "10:16-23", "10:24-29", "11:1-3"},
+		{"11:4-10", "12:1-10", "12:11-13", "12:14-16", "12:17-20", "12:21-24", "12:25-28"},
+		{"12:29-32", "12:33-36", "12:37-42", "12:43-51", "13:1-4", "13:5-10", "13:11-16"},
+	},
+	"Beshalach": {
+		{"13:17-22", "14:1-8", "14:9-14", "14:15-20", "14:21-25", "14:26-15:21", "15:22-26"},
+		{"15:27-16:3", "16:4-7", "16:8-10", "16:11-15", "16:16-19", "16:20-24", "16:25-27"},
+		{"16:28-30", "16:31-36", "17:1-3", "17:4-7", "17:8-10", "17:11-13", "17:14-16"},
+	},
+	"Yitro": {
+		{"18:1-4", "18:5-8", "18:9-12", "18:13-16", "18:17-19", "18:20-23", "18:24-27"},
+		{"19:1-3", "19:4-6", "19:7-9", "19:10-11", "19:12-13", "19:14-16", "19:17-19"},
+		{"19:20-25", "20:1-6", "20:7-10", "20:11-14", "20:15-18", "20:19-21", "20:22-23"},
+	},
+	"Mishpatim": {
+		{"21:1-6", "21:7-11", "21:12-19", "21:20-27", "21:28-32", "21:33-36", "21:37-22:3"},
+		{"22:4-8", "22:9-12", "22:13-16", "22:17-23", "22:24-26", "22:27-30", "23:1-5"},
+		{"23:6-13", "23:14-19", "23:20-25", "23:26-33", "24:1-6", "24:7-11", "24:12-18"},
+	},
+	"Terumah": {
+		{"25:1-5", "25:6-9", "25:10-16", "25:17-22", "25:23-30", "25:31-33", "25:34-40"},
+		{"26:1-6", "26:7-11", "26:12-14", "26:15-21", "26:22-25", "26:26-30", "26:31-37"},
+		{"27:1-3", "27:4-5", "27:6-8", "27:9-12", "27:13-15", "27:16-17", "27:18-19"},
+	},
+	"Tetzaveh": {
+		{"27:20-28:5", "28:6-9", "28:10-12", "28:13-17", "28:18-21", "28:22-25", "28:26-30"},
+		{"28:31-35", "28:36-38", "28:39-43", "29:1-4", "29:5-9", "29:10-14", "29:15-18"},
+		{"29:19-21", "29:22-25", "29:26-30", "29:31-34", "29:35-37", "29:38-46", "30:1-10"},
+	},
+	"Ki Tisa": {
+		{"30:11-13", "30:14-16", "30:17-21", "30:22-33", "30:34-38", "31:1-11", "31:12-17"},
+		{"31:18-32:6", "32:7-11", "32:12-14", "32:15-24", "32:25-29", "32:30-33:6", "33:7-11"},
+		{"33:12-16", "33:17-23", "34:1-9", "34:10-17", "34:18-21", "34:22-26", "34:27-35"},
+	},
+	"Vayakhel": {
+		{"35:1-3", "35:4-10", "35:11-20", "35:21-25", "35:26-29", "35:30-35", "36:1-7"},
+		{"36:8-13", "36:14-19", "36:20-26", "36:27-34", "36:35-38", "37:1-9", "37:10-16"},
+		{"37:17-21", "37:22-24", "37:25-29", "38:1-7", "38:8-11", "38:12-17", "38:18-20"},
+	},
+	"Pekudei": {
+		{"38:21-23", "38:24-27", "38:28-39:1", "39:2-5", "39:6-7", "39:8-14", "39:15-21"},
+		{"39:22-26", "39:27-29", "39:30-32", "39:33-35", "39:36-38", "39:39-41", "39:42-43"},
+		{"40:1-8", "40:9-16", "40:17-19", "40:20-27", "40:28-32", "40:33-35", "40:36-38"},
+	},
+	"Vayakhel-Pekudei": {
+		{"35:1-10", "35:11-20", "35:21-29", "35:30-36:7", "38:21-39:1", "39:2-7", "39:8-21"},
+		{"36:8-19", "36:20-30", "36:31-38", "37:1-16", "39:22-32", "39:33-38", "39:39-43"},
+		{"37:17-29", "38:1-11", "38:12-20", "40:1-16", "40:17-27", "40:28-33", "40:34-38"},
+	},
+	"Vayikra": {
+		{"1:1-4", "1:5-9", "1:10-13", "1:14-17", "2:1-6", "2:7-10", "2:11-16"},
+		{"3:1-5", "3:6-11", "3:12-17", "4:1-7", "4:8-12", "4:13-21", "4:22-26"},
+		{"4:27-31", "4:32-35", "5:1-4", "5:5-10", "5:11-13", "5:14-19", "5:20-26"},
+	},
+	"Tzav": {
+		{"6:1-3", "6:4-6", "6:7-11", "6:12-16", "6:17-23", "7:1-6", "7:7-10"},
+		{"7:11-15", "7:16-18", "7:19-21", "7:22-27", "7:28-31", "7:32-34", "7:35-38"},
+		{"8:1-5", "8:6-9", "8:10-13", "8:14-17", "8:18-21", "8:22-29", "8:30-36"},
+	},
+	"Shmini": {
+		{"9:1-6", "9:7-10", "9:11-16", "9:17-23", "9:24-10:3", "10:4-7", "10:8-11"},
+		{"10:12-15", "10:16-20", "11:1-4", "11:5-8", "11:9-12", "11:13-19", "11:20-28"},
+		{"11:29-32", "11:33-35", "11:36-38", "11:39-40", "11:41-42", "11:43-45", "11:46-47"},
+	},
+	"Tazria": {
+		{"12:1-4", "12:5-8", "13:1-3", "13:4-5", "13:6-8", "13:9-13", "13:14-17"},
+		{"13:18-20", "13:21-23", "13:24-28", "13:29-31", "13:32-34", "13:35-37", "13:38-39"},
+		{"13:40-44", "13:45-46", "13:47-50", "13:51-52", "13:53-54", "13:55-57", "13:58-59"},
+	},
+	"Metzora": {
+		{"14:1-5", "14:6-9", "14:10-12", "14:13-18", "14:19-20", "14:21-25", "14:26-32"},
+		{"14:33-38", "14:39-42", "14:43-47", "14:48-53", "14:54-57", "15:1-7", "15:8-15"},
+		{"15:16-18", "15:19-21", "15:22-24", "15:25-26", "15:27-28", "15:29-30", "15:31-33"},
+	},
+	"Tazria-Metzora": {
+		{"12:1-8", "13:1-8", "13:9-17", "14:1-9", "14:10-18", "14:19-25", "14:26-32"},
+		{"13:18-28", "13:29-39", "14:33-42", "14:43-53", "14:54-15:7", "15:8-12", "15:13-15"},
+		{"13:40-46", "13:47-54", "13:55-59", "15:16-18", "15:19-24", "15:25-30", "15:31-33"},
+	},
+	"Achrei Mot": {
+		{"16:1-3", "16:4-6", "16:7-11", "16:12-17", "16:18-24", "16:25-30", "16:31-34"},
+		{"17:1-2", "17:3-4", "17:5-7", "17:8-9", "17:10-12", "17:13-16", "18:1-5"},
+		{"18:6-11", "18:12-16", "18:17-19", "18:20-21", "18:22-23", "18:24-28", "18:29-30"},
+	},
+	"Kedoshim": {
+		{"19:1-4", "19:5-8", "19:9-10", "19:11-14", "19:15-16", "19:17-18", "19:19-22"},
+		{"19:23-25", "19:26-28", "19:29-30", "19:31-32", "19:33-37", "20:1-4", "20:5-7"},
+		{"20:8-10", "20:11-13", "20:14-16", "20:17-19", "20:20-22", "20:23-24", "20:25-27"},
+	},
+	"Achrei Mot-Kedoshim": {
+		{"16:1-11", "16:12-24", "16:25-34", "19:1-4", "19:5-10", "19:11-16", "19:17-22"},
+		{"17:1-7", "17:8-16", "18:1-5", "19:23-28", "19:29-32", "19:33-37", "20:1-7"},
+		{"18:6-16", "18:17-23", "18:24-30", "20:8-16", "20:17-22", "20:23-24", "20:25-27"},
+	},
+	"Emor": {
+		{"21:1-6", "21:7-12", "21:13-15", "21:16-24", "22:1-9", "22:10-12", "22:13-16"},
+		{"22:17-20", "22:21-25", "22:26-33", "23:1-8", "23:9-14", "23:15-18", "23:19-22"},
+		{"23:23-25", "23:26-32", "23:33-38", "23:39-44", "24:1-9", "24:10-16", "24:17-23"},
+	},
+	"Behar": {
+		{"25:1-3", "25:4-5", "25:6-7", "25:8-10", "25:11-13", "25:14-16", "25:17-18"},
+		{"25:19-22", "25:23-24", "25:25-28", "25:29-31", "25:32-34", "25:35-36", "25:37-38"},
+		{"25:39-41", "25:42-43", "25:44-46", "25:47-50", "25:51-52", "25:53-55", "26:1-2"},
+	},
+	"Bechukotai": {
+		{"26:3-5", "26:6-9", "26:10-13", "26:14-17", "26:18-20", "26:21-23", "26:24-26"},
+		{"26:27-33", "26:34-38", "26:39-41", "26:42-46", "27:1-8", "27:9-13", "27:14-15"},
+		{"27:16-19", "27:20-21", "27:22-24", "27:25-27", "27:28-29", "27:30-31", "27:32-34"},
+	},
+	"Behar-Bechukotai": {
+		{"25:1-7", "25:8-13", "25:14-18", "26:3-9", "26:10-13", "26:14-20", "26:21-26"},
+		{"25:19-24", "25:25-31", "25:32-38", "26:27-38", "26:39-46", "27:1-8", "27:9-15"},
+		{"25:39-46", "25:47-55", "26:1-2", "27:16-21", "27:22-25", "27:26-29", "27:30-34"},
+	},
+	"Bamidbar": {
+		{"1:1-4", "1:5-16", "1:17-19", "1:20-27", "1:28-35", "1:36-43", "1:44-54"},
+		{"2:1-9", "2:10-16", "2:17-24", "2:25-31", "2:32-34", "3:1-4", "3:5-13"},
+		{"3:14-20", "3:21-26", "3:27-32", "3:33-39", "3:40-51", "4:1-10", "4:11-20"},
+	},
+	"Nasso": {
+		{"4:21-24", "4:25-28", "4:29-33", "4:34-37", "4:38-41", "4:42-45", "4:46-49"},
+		{"5:1-4", "5:5-10", "5:11-20", "5:21-31", "6:1-12", "6:13-21", "6:22-27"},
+		{"7:1-11", "7:12-23", "7:24-35", "7:36-47", "7:48-59", "7:60-71", "7:72-89"},
+	},
+	"Beha'alotcha": {
+		{"8:1-4", "8:5-8", "8:9-14", "8:15-22", "8:23-26", "9:1-8", "9:9-14"},
+		{"9:15-18", "9:19-23", "10:1-7", "10:8-10", "10:11-20", "10:21-28", "10:29-34"},
+		{"10:35-11:9", "11:10-18", "11:19-22", "11:23-29", "11:30-35", "12:1-8", "12:9-16"},
+	},
+	"Sh'lach": {
+		{"13:1-3", "13:4-16", "13:17-20", "13:21-24", "13:25-30", "13:31-33", "14:1-7"},
+		{"14:8-10", "14:11-16", "14:17-20", "14:21-25", "14:26-35", "14:36-45", "15:1-7"},
+		{"15:8-13", "15:14-16", "15:17-21", "15:22-26", "15:27-31", "15:32-36", "15:37-41"},
+	},
+	"Korach": {
+		{"16:1-3", "16:4-7", "16:8-10", "16:11-13", "16:14-15", "16:16-17", "16:18-19"},
+		{"16:20-27", "16:28-35", "17:1-5", "17:6-8", "17:9-10", "17:11-13", "17:14-15"},
+		{"17:16-24", "17:25-28", "18:1-7", "18:8-13", "18:14-20", "18:21-24", "18:25-32"},
+	},
+	"Chukat": {
+		{"19:1-6", "19:7-9", "19:10-13", "19:14-17", "19:18-22", "20:1-3", "20:4-6"},
+		{"20:7-13", "20:14-17", "20:18-21", "20:22-29", "21:1-3", "21:4-6", "21:7-9"},
+		{"21:10-13", "21:14-16", "21:17-20", "21:21-25", "21:26-28", "21:29-31", "21:32-22:1"},
+	},
+	"Balak": {
+		{"22:2-4", "22:5-7", "22:8-12", "22:13-20", "22:21-27", "22:28-35", "22:36-38"},
+		{"22:39-23:6", "23:7-12", "23:13-17", "23:18-26", "23:27-30", "24:1-9", "24:10-13"},
+		{"24:14-16", "24:17-19", "24:20-22", "24:23-25", "25:1-3", "25:4-6", "25:7-9"},
+	},
+	"Chukat-Balak": {
+		{"19:1-9", "19:10-17", "19:18-20:6", "22:2-12", "22:13-20", "22:21-30", "22:31-38"},
+		{"20:7-13", "20:14-21", "20:22-21:9", "22:39-23:12", "23:13-26", "23:27-24:6", "24:7-13"},
+		{"21:10-20", "21:21-32", "21:33-22:1", "24:14-19", "24:20-25", "25:1-6", "25:7-9"},
+	},
+	"Pinchas": {
+		{"25:10-15", "25:16-26:4", "26:5-11", "26:12-22", "26:23-34", "26:35-43", "26:44-51"},
+		{"26:52-56", "26:57-62", "26:63-65", "27:1-5", "27:6-11", "27:12-14", "27:15-23"},
+		{"28:1-8", "28:9-15", "28:16-25", "28:26-31", "29:1-11", "29:12-28", "29:29-30:1"},
+	},
+	"Matot": {
+		{"30:2-6", "30:7-9", "30:10-13", "30:14-17", "31:1-4", "31:5-8", "31:9-12"},
+		{"31:13-18", "31:19-24", "31:25-30", "31:31-41", "31:42-47", "31:48-50", "31:51-54"},
+		{"32:1-5", "32:6-15", "32:16-19", "32:20-24", "32:25-27", "32:28-32", "32:33-42"},
+	},
+	"Masei": {
+		{"33:1-3", "33:4-10", "33:11-17", "33:18-28", "33:29-36", "33:37-43", "33:44-49"},
+		{"33:50-53", "33:54-56", "34:1-9", "34:10-15", "34:16-21", "34:22-25", "34:26-29"},
+		{"35:1-8", "35:9-15", "35:16-21", "35:22-29", "35:30-34", "36:1-9", "36:10-13"},
+	},
+	"Matot-Masei": {
+		{"30:2-9", "30:10-17", "31:1-12", "33:1-10", "33:11-20", "33:21-36", "33:37-49"},
+		{"31:13-24", "31:25-41", "31:42-54", "33:50-56", "34:1-15", "34:16-22", "34:23-29"},
+		{"32:1-15", "32:16-27", "32:28-42", "35:1-8", "35:9-21", "35:22-34", "36:1-13"},
+	},
+	"Devarim": {
+		{"1:1-3", "1:4-7", "1:8-10", "1:11-13", "1:14-16", "1:17-18", "1:19-21"},
+		{"1:22-24", "1:25-28", "1:29-33", "1:34-36", "1:37-38", "1:39-43", "1:44-2:1"},
+		{"2:2-8", "2:9-16", "2:17-25", "2:26-30", "2:31-3:7", "3:8-14", "3:15-22"},
+	},
+	"Vaetchanan": {
+		{"3:23-25", "3:26-29", "4:1-4", "4:5-10", "4:11-20", "4:21-32", "4:33-40"},
+		{"4:41-49", "5:1-5", "5:6-14", "5:15-18", "5:19-24", "5:25-30", "6:1-3"},
+		{"6:4-9", "6:10-15", "6:16-19", "6:20-25", "7:1-5", "7:6-8", "7:9-11"},
+	},
+	"Eikev": {
+		{"7:12-16", "7:17-21", "7:22-26", "8:1-5", "8:6-10", "8:11-18", "8:19-9:3"},
+		{"9:4-10", "9:11-14", "9:15-21", "9:22-29", "10:1-5", "10:6-8", "10:9-11"},
+		{"10:12-15", "10:16-22", "11:1-9", "11:10-12", "11:13-17", "11:18-21", "11:22-25"},
+	},
+	"Re'eh": {
+		{"11:26-31", "11:32-12:5", "12:6-10", "12:11-16", "12:17-19", "12:20-25", "12:26-28"},
+		{"12:29-13:1", "13:2-6", "13:7-12", "13:13-19", "14:1-8", "14:9-21", "14:22-29"},
+		{"15:1-6", "15:7-11", "15:12-18", "15:19-23", "16:1-8", "16:9-12", "16:13-17"},
+	},
+	"Shoftim": {
+		{"16:18-20", "16:21-17:7", "17:8-10", "17:11-13", "17:14-17", "17:18-20", "18:1-5"},
+		{"18:6-8", "18:9-13", "18:14-17", "18:18-22", "19:1-7", "19:8-10", "19:11-13"},
+		{"19:14-21", "20:1-4", "20:5-9", "20:10-14", "20:15-20", "21:1-5", "21:6-9"},
+	},
+	"Ki Teitzei": {
+		{"21:10-14", "21:15-17", "21:18-21", "21:22-23", "22:1-3", "22:4-5", "22:6-7"},
+		{"22:8-12", "22:13-19", "22:20-29", "23:1-7", "23:8-11", "23:12-19", "23:20-24"},
+		{"23:25-24:4", "24:5-9", "24:10-13", "24:14-18", "24:19-22", "25:1-10", "25:11-19"},
+	},
+	"Ki Tavo": {
+		{"26:1-3", "26:4-8", "26:9-11", "26:12-15", "26:16-19", "27:1-4", "27:5-10"},
+		{"27:11-13", "27:14-16", "27:17-19", "27:20-23", "27:24-26", "28:1-3", "28:4-6"},
+		{"28:7-8", "28:9-11", "28:12-14", "28:15-69", "29:1-3", "29:4-5", "29:6-8"},
+	},
+	"Nitzavim": {
+		{"29:9-11", "29:12-14", "29:15-28", "30:1-6", "30:7-10", "30:11-14", "30:15-20"},
+		{"29:9-11", "29:12-14", "29:15-28", "30:1-6", "30:7-10", "30:11-14", "30:15-20"},
+		{"29:9-11", "29:12-14", "29:15-28", "30:1-6", "30:7-10", "30:11-14", "30:15-20"},
+	},
+	"Vayeilech": {
+		{"31:1-3", "31:4-6", "31:7-9", "31:10-13", "31:14-19", "31:20-24", "31:25-30"},
+		{"31:1-3", "31:4-6", "31:7-9", "31:10-13", "31:14-19", "31:20-24", "31:25-30"},
+		{"31:1-3", "31:4-6", "31:7-9", "31:10-13", "31:14-19", "31:20-24", "31:25-30"},
+	},
+	"Nitzavim-Vayeilech": {
+		{"29:9-28", "30:1-6", "30:7-14", "30:15-31:3", "31:4-6", "31:7-13", "31:14-30"},
+		{"29:9-28", "30:1-6", "30:7-14", "30:15-31:3", "31:4-6", "31:7-13", "31:14-30"},
+		{"29:9-28", "30:1-6", "30:7-14", "30:15-31:3", "31:4-6", "31:7-13", "31:14-30"},
+	},
+	"Ha'azinu": {
+		{"32:1-6", "32:7-12", "32:13-18", "32:19-28", "32:29-39", "32:40-43", "32:44-52"},
+		{"32:1-6", "32:7-12", "32:13-18", "32:19-28", "32:29-39", "32:40-43", "32:44-52"},
+		{"32:1-6", "32:7-12", "32:13-18", "32:19-28", "32:29-39", "32:40-43", "32:44-52"},
+	},
+}
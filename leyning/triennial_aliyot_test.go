@@ -0,0 +1,58 @@
+package leyning
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// triennialVerses returns every verse read in year cycleYear (1, 2 or 3)
+// of the triennial reading of name, in order.
+func triennialVerses(name string, cycleYear int) []verse {
+	var result []verse
+	for _, s := range triennialAliyot[name][cycleYear-1] {
+		result = append(result, parseAliyah(parshiyot[name].book, s).verses()...)
+	}
+	return result
+}
+
+func TestTriennialAliyotCoverParsha(t *testing.T) {
+	assert := assert.New(t)
+	readEveryYear := map[string]bool{
+		"Nitzavim": true, "Vayeilech": true, "Nitzavim-Vayeilech": true, "Ha'azinu": true,
+	}
+	for name := range parshiyot {
+		_, ok := triennialAliyot[name]
+		assert.True(ok, name)
+	}
+	for name := range triennialAliyot {
+		reading, ok := parshiyot[name]
+		if !assert.True(ok, name) {
+			continue
+		}
+		var full []verse
+		for _, s := range reading.aliyot[:7] {
+			full = append(full, parseAliyah(reading.book, s).verses()...)
+		}
+		switch first, second, doubled := strings.Cut(name, "-"); {
+		case readEveryYear[name]:
+			for cycleYear := 1; cycleYear <= 3; cycleYear++ {
+				assert.Equal(full, triennialVerses(name, cycleYear), name)
+			}
+		case doubled && name != "Lech-Lecha":
+			// each year, the portions of that year of both parshiyot
+			for cycleYear := 1; cycleYear <= 3; cycleYear++ {
+				expected := append(triennialVerses(first, cycleYear), triennialVerses(second, cycleYear)...)
+				assert.Equal(expected, triennialVerses(name, cycleYear), name)
+			}
+		default:
+			// the three years read the parsha once, from beginning to end
+			var all []verse
+			for cycleYear := 1; cycleYear <= 3; cycleYear++ {
+				all = append(all, triennialVerses(name, cycleYear)...)
+			}
+			assert.Equal(full, all, name)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package leyning_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/leyning"
+	"github.com/hebcal/hebcal-go/sedra"
+)
+
+func TestTriennialCycleYear(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(1, leyning.TriennialCycleYear(5744))
+	assert.Equal(3, leyning.TriennialCycleYear(5743))
+	assert.Equal(3, leyning.TriennialCycleYear(5785))
+	assert.Equal(1, leyning.TriennialCycleYear(5786))
+	assert.Equal(2, leyning.TriennialCycleYear(5787))
+}
+
+func TestGetTriennialForParsha(t *testing.T) {
+	assert := assert.New(t)
+	bereshit := sedra.Parsha{Name: []string{"Bereshit"}, Num: []int{1}}
+	l, err := leyning.GetTriennialForParsha(bereshit, 1)
+	assert.NoError(err)
+	assert.Equal("Bereshit", l.Name)
+	assert.Equal("Genesis 1:1-2:3", l.Summary)
+	assert.Equal([]string{
+		"Genesis 1:1-5",
+		"Genesis 1:6-8",
+		"Genesis 1:9-13",
+		"Genesis 1:14-19",
+		"Genesis 1:20-23",
+		"Genesis 1:24-31",
+		"Genesis 2:1-3",
+		"Genesis 2:1-3",
+	}, aliyotStrings(l))
+	assert.Equal("Isaiah 42:5-43:10", l.Haftara)
+
+	l, err = leyning.GetTriennialForParsha(bereshit, 2)
+	assert.NoError(err)
+	assert.Equal("Genesis 2:4-3:21", l.Summary)
+	l, err = leyning.GetTriennialForParsha(bereshit, 3)
+	assert.NoError(err)
+	assert.Equal("Genesis 3:22-6:8", l.Summary)
+	assert.Equal("Genesis 6:6-8", l.Maftir.String())
+
+	_, err = leyning.GetTriennialForParsha(bereshit, 4)
+	assert.Error(err)
+	_, err = leyning.GetTriennialForParsha(sedra.Parsha{Chag: true}, 1)
+	assert.Error(err)
+}
+
+func TestGetTriennialDoubled(t *testing.T) {
+	assert := assert.New(t)
+	// read separately in the second year
+	vayakhel := sedra.Parsha{Name: []string{"Vayakhel"}, Num: []int{22}}
+	pekudei := sedra.Parsha{Name: []string{"Pekudei"}, Num: []int{23}}
+	l, err := leyning.GetTriennialForParsha(vayakhel, 2)
+	assert.NoError(err)
+	assert.Equal("Exodus 36:8-37:16", l.Summary)
+	l, err = leyning.GetTriennialForParsha(pekudei, 2)
+	assert.NoError(err)
+	assert.Equal("Exodus 39:22-43", l.Summary)
+
+	// doubled, the second-year portions of both
+	both := sedra.Parsha{Name: []string{"Vayakhel", "Pekudei"}, Num: []int{22, 23}}
+	l, err = leyning.GetTriennialForParsha(both, 2)
+	assert.NoError(err)
+	assert.Equal("Vayakhel-Pekudei", l.Name)
+	assert.Equal("Exodus 36:8-37:16; 39:22-43", l.Summary)
+	assert.Equal([]string{
+		"Exodus 36:8-19",
+		"Exodus 36:20-30",
+		"Exodus 36:31-38",
+		"Exodus 37:1-16",
+		"Exodus 39:22-32",
+		"Exodus 39:33-38",
+		"Exodus 39:39-43",
+		"Exodus 39:41-43",
+	}, aliyotStrings(l))
+}
+
+func TestTriennialEveryYear(t *testing.T) {
+	assert := assert.New(t)
+	// Ha'azinu is read in full every year
+	haazinu := sedra.Parsha{Name: []string{"Ha'azinu"}, Num: []int{53}}
+	full, err := leyning.GetLeyningForParsha(haazinu)
+	assert.NoError(err)
+	for cycleYear := 1; cycleYear <= 3; cycleYear++ {
+		l, err := leyning.GetTriennialForParsha(haazinu, cycleYear)
+		assert.NoError(err)
+		assert.Equal(full.Aliyot, l.Aliyot)
+		assert.Equal("Deuteronomy 32:50-52", l.Maftir.String())
+	}
+}
+
+func TestTriennialCoversParsha(t *testing.T) {
+	assert := assert.New(t)
+	// over the three years of the cycle every parsha is read from its
+	// first verse to its last
+	s := sedra.New(5783, false)
+	start := hdate.New(5783, hdate.Tishrei, 1).OnOrAfter(time.Saturday)
+	end := hdate.New(5784, hdate.Tishrei, 1).Abs()
+	for abs := start.Abs(); abs < end; abs += 7 {
+		parsha := s.LookupByRD(abs)
+		if parsha.Chag {
+			continue
+		}
+		for _, name := range parsha.Name {
+			p := sedra.Parsha{Name: []string{name}}
+			full, err := leyning.GetLeyningForParsha(p)
+			assert.NoError(err)
+			var years []leyning.Leyning
+			for cycleYear := 1; cycleYear <= 3; cycleYear++ {
+				l, err := leyning.GetTriennialForParsha(p, cycleYear)
+				assert.NoError(err)
+				assert.Equal(7, len(l.Aliyot))
+				assert.Equal(l.Aliyot[6].End, l.Maftir.End)
+				years = append(years, l)
+			}
+			assert.Equal(full.Aliyot[0].Begin, years[0].Aliyot[0].Begin, name)
+			assert.Equal(full.Aliyot[6].End, years[2].Aliyot[6].End, name)
+		}
+	}
+}
+
+func TestGetTriennialOnDate(t *testing.T) {
+	assert := assert.New(t)
+	// 5784 is the third year of the cycle: Shabbat Zachor, 13 Adar II
+	l, ok := leyning.GetTriennialOnDate(hdate.New(5784, hdate.Adar2, 13), false)
+	assert.True(ok)
+	assert.Equal("Vayikra", l.Name)
+	assert.Equal("Deuteronomy 25:17-19", l.Maftir.String())
+	assert.Equal("I Samuel 15:2-34", l.Haftara)
+	assert.Equal("Shabbat Zachor", l.Reason["maftir"])
+
+	_, ok = leyning.GetTriennialOnDate(hdate.New(5784, hdate.Adar2, 12), false)
+	assert.False(ok)
+}
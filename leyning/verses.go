@@ -0,0 +1,120 @@
+package leyning
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"strconv"
+	"strings"
+)
+
+// versesPerChapter is the number of verses in each chapter of the Torah,
+// following the Hebrew (not the English) chapter and verse numbering.
+var versesPerChapter = map[string][]int{
+	"Genesis": {31, 25, 24, 26, 32, 22, 24, 22, 29, 32, 32, 20, 18, 24, 21, 16, 27, 33, 38, 18,
+		34, 24, 20, 67, 34, 35, 46, 22, 35, 43, 54, 33, 20, 31, 29, 43, 36, 30, 23, 23,
+		57, 38, 34, 34, 28, 34, 31, 22, 33, 26},
+	"Exodus": {22, 25, 22, 31, 23, 30, 29, 28, 35, 29, 10, 51, 22, 31, 27, 36, 16, 27, 25, 23,
+		37, 30, 33, 18, 40, 37, 21, 43, 46, 38, 18, 35, 23, 35, 35, 38, 29, 31, 43, 38},
+	"Leviticus": {17, 16, 17, 35, 26, 23, 38, 36, 24, 20, 47, 8, 59, 57, 33, 34, 16, 30, 37, 27,
+		24, 33, 44, 23, 55, 46, 34},
+	"Numbers": {54, 34, 51, 49, 31, 27, 89, 26, 23, 36, 35, 16, 33, 45, 41, 35, 28, 32, 22, 29,
+		35, 41, 30, 25, 19, 65, 23, 31, 39, 17, 54, 42, 56, 29, 34, 13},
+	"Deuteronomy": {46, 37, 29, 49, 30, 25, 26, 20, 29, 22, 32, 31, 19, 29, 23, 22, 20, 22, 21, 20,
+		23, 29, 26, 22, 19, 19, 26, 69, 28, 20, 30, 52, 29, 12},
+}
+
+// verse is a chapter and verse within a book.
+type verse struct {
+	chapter int
+	verse   int
+}
+
+func parseVerse(s string) verse {
+	chapter, v, _ := strings.Cut(s, ":")
+	c, _ := strconv.Atoi(chapter)
+	n, _ := strconv.Atoi(v)
+	return verse{c, n}
+}
+
+func (v verse) String() string {
+	return strconv.Itoa(v.chapter) + ":" + strconv.Itoa(v.verse)
+}
+
+// verses returns every verse of a, in order.
+func (a Aliyah) verses() []verse {
+	counts := versesPerChapter[a.Book]
+	begin, end := parseVerse(a.Begin), parseVerse(a.End)
+	var result []verse
+	for v := begin; !end.before(v); v = v.next(counts) {
+		result = append(result, v)
+	}
+	return result
+}
+
+// before reports whether v precedes w.
+func (v verse) before(w verse) bool {
+	return v.chapter < w.chapter || (v.chapter == w.chapter && v.verse < w.verse)
+}
+
+// next returns the verse following v, given the number of verses in each
+// chapter of its book.
+func (v verse) next(counts []int) verse {
+	if v.verse < counts[v.chapter-1] {
+		return verse{v.chapter, v.verse + 1}
+	}
+	return verse{v.chapter + 1, 1}
+}
+
+// summarize returns the verse ranges covered by aliyot, merging those
+// that are contiguous with or overlap the previous one, e.g. "Exodus 32:11-14; 34:1-10" or
+// "Exodus 13:1-16; Numbers 28:19-25".
+func summarize(aliyot []Aliyah) string {
+	var ranges []Aliyah
+	for _, a := range aliyot {
+		if n := len(ranges); n > 0 && ranges[n-1].Book == a.Book {
+			last := &ranges[n-1]
+			counts := versesPerChapter[a.Book]
+			begin, end := parseVerse(a.Begin), parseVerse(a.End)
+			lastBegin, lastEnd := parseVerse(last.Begin), parseVerse(last.End)
+			switch {
+			case begin.before(lastBegin) || lastEnd.next(counts).before(begin):
+				ranges = append(ranges, a)
+			case lastEnd.before(end):
+				last.End = a.End
+			}
+			continue
+		}
+		ranges = append(ranges, a)
+	}
+	var sb strings.Builder
+	for i, r := range ranges {
+		s := r.String()
+		if i > 0 {
+			sb.WriteString("; ")
+			if ranges[i-1].Book == r.Book {
+				s = strings.TrimPrefix(s, r.Book+" ")
+			}
+		}
+		sb.WriteString(s)
+	}
+	return sb.String()
+}
+
+// versesToAliyah returns the Aliyah from the first to the last of vs.
+func versesToAliyah(book string, vs []verse) Aliyah {
+	return Aliyah{Book: book, Begin: vs[0].String(), End: vs[len(vs)-1].String()}
+}
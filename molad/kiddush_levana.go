@@ -0,0 +1,60 @@
+package molad
+
+import (
+	"time"
+)
+
+// Molad times are traditionally expressed in the local mean time of
+// Jerusalem (JMT), measured at the longitude of Har Habayit (35.2354°E).
+// That is 35.2354 * 4 = 140.9416 minutes, or 2:20:56.496, ahead of UTC,
+// and 20.94 minutes ahead of Israel Standard Time.
+const jerusalemMeanTimeOffset = 2*time.Hour + 20*time.Minute + 56*time.Second + 496*time.Millisecond
+
+// Half of an average lunar month (29 days, 12 hours and 793 chalakim):
+// 14 days, 18 hours, 22 minutes and 1.666 seconds.
+const halfMonth = (14*24+18)*time.Hour + 22*time.Minute + 1666*time.Millisecond
+
+const day = 24 * time.Hour
+
+// Time returns the moment of the molad as a time.Time in UTC. The
+// Date, Hours, Minutes and Chalakim of a Molad are a wall-clock time in
+// Jerusalem mean time; this converts them to an absolute instant that can
+// be displayed in any time zone with Time.In.
+//
+// This matches getMoladAsDate() in KosherJava's JewishCalendar.
+func (m Molad) Time() time.Time {
+	year, month, mday := m.Date.Greg()
+	// A chelek is 1/18 of a minute, or 3⅓ seconds.
+	chalakim := time.Duration(m.Chalakim) * 10 * time.Second / 3
+	jmt := time.Date(year, month, mday, m.Hours, m.Minutes, 0, 0, time.UTC).Add(chalakim)
+	return jmt.Add(-jerusalemMeanTimeOffset)
+}
+
+// TchilasZmanKidushLevana3Days returns the earliest time for Kiddush
+// Levana according to the opinion that it may be said 3 days (72 hours)
+// after the molad.
+func (m Molad) TchilasZmanKidushLevana3Days() time.Time {
+	return m.Time().Add(3 * day)
+}
+
+// TchilasZmanKidushLevana7Days returns the earliest time for Kiddush
+// Levana according to the opinion of the Shulchan Aruch (Orach Chaim 426:4)
+// that it should not be said until 7 days after the molad.
+func (m Molad) TchilasZmanKidushLevana7Days() time.Time {
+	return m.Time().Add(7 * day)
+}
+
+// SofZmanKidushLevanaBetweenMoldos returns the latest time for Kiddush
+// Levana according to the Maharil's opinion that it may be said until
+// halfway between one molad and the next: 14 days, 18 hours, 22 minutes
+// and 1.666 seconds after the molad.
+func (m Molad) SofZmanKidushLevanaBetweenMoldos() time.Time {
+	return m.Time().Add(halfMonth)
+}
+
+// SofZmanKidushLevana15Days returns the latest time for Kiddush Levana
+// according to the opinion of the Shulchan Aruch (Orach Chaim 426:3) that
+// it may be said until 15 days after the molad.
+func (m Molad) SofZmanKidushLevana15Days() time.Time {
+	return m.Time().Add(15 * day)
+}
@@ -0,0 +1,37 @@
+package molad_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/molad"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoladTime(t *testing.T) {
+	assert := assert.New(t)
+	// Molad Iyyar 5783: Thursday 14:08 and 13 chalakim, Jerusalem mean time
+	m := molad.New(5783, hdate.Iyyar)
+	expected := time.Date(2023, time.April, 20, 11, 47, 46, 837333333, time.UTC)
+	assert.Equal(expected, m.Time())
+	// Israel Standard Time is 20.94 minutes behind Jerusalem mean time
+	ist := time.FixedZone("IST", 2*60*60)
+	assert.Equal("2023-04-20 13:47:46", m.Time().In(ist).Format("2006-01-02 15:04:05"))
+}
+
+func TestKiddushLevana(t *testing.T) {
+	assert := assert.New(t)
+	m := molad.New(5783, hdate.Tishrei)
+	moladTime := m.Time()
+	assert.Equal(time.Date(2022, time.September, 25, 18, 39, 23, 504000000, time.UTC), moladTime)
+	assert.Equal(72*time.Hour, m.TchilasZmanKidushLevana3Days().Sub(moladTime))
+	assert.Equal(168*time.Hour, m.TchilasZmanKidushLevana7Days().Sub(moladTime))
+	assert.Equal(time.Date(2022, time.October, 10, 13, 1, 25, 170000000, time.UTC),
+		m.SofZmanKidushLevanaBetweenMoldos())
+	assert.Equal(15*24*time.Hour, m.SofZmanKidushLevana15Days().Sub(moladTime))
+	// halfway between moladot, as computed from the next molad
+	next := molad.New(5783, hdate.Cheshvan)
+	half := next.Time().Sub(moladTime) / 2
+	assert.InDelta(float64(half), float64(m.SofZmanKidushLevanaBetweenMoldos().Sub(moladTime)), float64(time.Millisecond))
+}
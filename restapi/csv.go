@@ -0,0 +1,116 @@
+package restapi
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/locales"
+)
+
+// CsvHeader is the first line of the classic Hebcal Outlook CSV export.
+const CsvHeader = `"Subject","Start Date","Start Time","End Date","End Time","All day event","Description","Show time as","Location"`
+
+// Values of the Outlook "Show time as" column.
+const (
+	showTimeAsFree = "3"
+	showTimeAsBusy = "4"
+)
+
+// EventToCsv renders a single event as a row of the classic Hebcal Outlook
+// CSV export, without a trailing newline.
+//
+// Dates are written as M/D/YYYY, or D/M/YYYY when opts.EuroDates is set.
+// Timed events (candle-lighting, Havdalah and so on) are written with a
+// start and end time taken from their EventTime in the Location's time zone
+// and with the Location's name; all other events are all-day events.
+func EventToCsv(ev event.CalEvent, opts *Options) string {
+	if opts == nil {
+		opts = &Options{}
+	}
+	locale := opts.locale()
+	year, month, day := ev.GetDate().Greg()
+	date := csvDate(time.Date(year, month, day, 0, 0, 0, 0, time.UTC), opts.EuroDates)
+	subject := ev.Render(locale)
+	var startTime, endDate, endTime, memo string
+	allDay := "true"
+	location := "Jewish Holidays"
+	showTimeAs := showTimeAsFree
+	if timed, ok := ev.(hebcal.TimedEvent); ok {
+		// The clock time has its own column, so omit it from the subject.
+		subject, _ = locales.LookupTranslation(timed.Desc, locale)
+		startTime = timed.EventTime.In(opts.timeZone()).Format("3:04 PM")
+		endDate = date
+		endTime = startTime
+		allDay = "false"
+		showTimeAs = showTimeAsBusy
+		if opts.Location != nil {
+			location = opts.Location.Name
+		}
+		if timed.LinkedEvent != nil && (timed.Flags&event.CHANUKAH_CANDLES) == 0 {
+			memo = timed.LinkedEvent.Render(locale)
+		}
+	} else {
+		if (ev.GetFlags() & event.CHAG) != 0 {
+			showTimeAs = showTimeAsBusy
+		}
+		memo = holidayMemo(ev, locale)
+	}
+	return strings.Join([]string{
+		csvQuote(subject),
+		date,
+		startTime,
+		endDate,
+		endTime,
+		csvQuote(allDay),
+		csvQuote(memo),
+		csvQuote(showTimeAs),
+		csvQuote(location),
+	}, ",")
+}
+
+// WriteCSV writes events to w as a classic Hebcal Outlook CSV file,
+// suitable for importing into Outlook or Google Calendar. Lines end in CRLF.
+func WriteCSV(w io.Writer, events []event.CalEvent, opts *Options) error {
+	if _, err := io.WriteString(w, CsvHeader+"\r\n"); err != nil {
+		return err
+	}
+	for _, ev := range events {
+		if _, err := io.WriteString(w, EventToCsv(ev, opts)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func csvDate(t time.Time, euro bool) string {
+	if euro {
+		return t.Format("2/1/2006")
+	}
+	return t.Format("1/2/2006")
+}
+
+// csvQuote returns s enclosed in double quotes, doubling any embedded
+// quotes and flattening newlines (RFC 4180).
+func csvQuote(s string) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
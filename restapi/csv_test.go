@@ -0,0 +1,51 @@
+package restapi_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/restapi"
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteCSV(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.LookupCity("Chicago")
+	events, err := hebcal.HebrewCalendar(&hebcal.CalOptions{
+		Start:          hdate.New(5782, hdate.Elul, 29),
+		End:            hdate.New(5783, hdate.Tishrei, 1),
+		CandleLighting: true,
+		Location:       loc,
+	})
+	assert.NoError(err)
+	var buf bytes.Buffer
+	err = restapi.WriteCSV(&buf, events, &restapi.Options{Location: loc})
+	assert.NoError(err)
+	expected := []string{
+		restapi.CsvHeader,
+		`"Erev Rosh Hashana",9/25/2022,,,,"true","The Jewish New Year. Also spelled Rosh Hashanah","3","Jewish Holidays"`,
+		`"Candle lighting",9/25/2022,6:24 PM,9/25/2022,6:24 PM,"false","Erev Rosh Hashana","4","Chicago"`,
+		`"Rosh Hashana 5783",9/26/2022,,,,"true","The Jewish New Year. Also spelled Rosh Hashanah","4","Jewish Holidays"`,
+		`"Candle lighting",9/26/2022,7:22 PM,9/26/2022,7:22 PM,"false","Rosh Hashana 5783","4","Chicago"`,
+		"",
+	}
+	assert.Equal(expected, strings.Split(buf.String(), "\r\n"))
+}
+
+func TestEventToCsvEuroLocale(t *testing.T) {
+	assert := assert.New(t)
+	ev := event.UserEvent{Date: hdate.New(5783, hdate.Tishrei, 1), Desc: `Say "hello"`}
+	assert.Equal(`"Say ""hello""",26/9/2022,,,,"true","","3","Jewish Holidays"`,
+		restapi.EventToCsv(ev, &restapi.Options{EuroDates: true}))
+	events, _ := hebcal.HebrewCalendar(&hebcal.CalOptions{
+		Start: hdate.New(5783, hdate.Tishrei, 10),
+		End:   hdate.New(5783, hdate.Tishrei, 10),
+	})
+	assert.Equal(`"Yom Kippour",5/10/2022,,,,"true","Day of Atonement. The holiest day of the year in Judaism, traditionally observed with a 25-hour period of fasting and intensive prayer","4","Jewish Holidays"`,
+		restapi.EventToCsv(events[0], &restapi.Options{Locale: "fr", EuroDates: true}))
+}
@@ -0,0 +1,286 @@
+// Hebcal's restapi package renders calendar events in the same shapes as
+// the hebcal.com REST API, so that programs written against hebcal.com can
+// generate identical responses offline.
+//
+// This package is modeled on the @hebcal/rest-api TypeScript package.
+package restapi
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/leyning"
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/hebcal/locales"
+)
+
+// Options configure how events are rendered by EventToItem,
+// EventsToClassicApi and EventToCsv.
+type Options struct {
+	// Locale used for the "title" and "memo" fields (default "en").
+	// The "hebrew" field is always rendered in Hebrew without nikud.
+	Locale string
+	// Location of the calendar. Timed events are rendered in its time zone,
+	// and it is described in the "location" field of the response. It should
+	// be the same Location passed to hebcal.HebrewCalendar.
+	Location *zmanim.Location
+	// Israel holiday and sedra schedule, used for Torah reading links.
+	IL bool
+	// Title of the response. Defaults to "Hebcal".
+	Title string
+	// Timestamp of the response. If zero, the current time is used.
+	Now time.Time
+	// Write CSV dates as D/M/YYYY instead of the US M/D/YYYY.
+	EuroDates bool
+}
+
+// Item is a single event in the hebcal.com "items" format.
+type Item struct {
+	Title     string `json:"title"`
+	Date      string `json:"date"`
+	HDate     string `json:"hdate,omitempty"`
+	Category  string `json:"category"`
+	Subcat    string `json:"subcat,omitempty"`
+	TitleOrig string `json:"title_orig,omitempty"`
+	Hebrew    string `json:"hebrew,omitempty"`
+	Link      string `json:"link,omitempty"`
+	Memo      string `json:"memo,omitempty"`
+	// Leyning is the Torah and Haftara reading of a weekly Torah portion
+	// or a holiday, keyed by "torah", "haftarah", "haftarah_sephardic",
+	// "maftir", "megillah" and the aliyah numbers "1" through "7".
+	Leyning map[string]string `json:"leyning,omitempty"`
+}
+
+// LocationInfo describes the location of a calendar.
+type LocationInfo struct {
+	Title     string  `json:"title"`
+	City      string  `json:"city"`
+	TZID      string  `json:"tzid"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	CC        string  `json:"cc,omitempty"`
+	Elevation int     `json:"elevation,omitempty"`
+}
+
+// Range is the first and last Gregorian date of a calendar, formatted as
+// YYYY-MM-DD.
+type Range struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Result is a complete hebcal.com "classic API" response.
+type Result struct {
+	Title    string        `json:"title"`
+	Date     string        `json:"date"`
+	Location *LocationInfo `json:"location,omitempty"`
+	Range    *Range        `json:"range,omitempty"`
+	Items    []Item        `json:"items"`
+}
+
+// isoDate is the YYYY-MM-DD layout used for all-day events.
+const isoDate = "2006-01-02"
+
+const baseURL = "https://www.hebcal.com"
+
+func (opts *Options) locale() string {
+	if opts.Locale == "" {
+		return "en"
+	}
+	return opts.Locale
+}
+
+// timeZone returns the time zone of the Location, or UTC.
+func (opts *Options) timeZone() *time.Location {
+	if opts.Location != nil {
+		if tz, err := zmanim.LoadLocation(opts.Location.TimeZoneId); err == nil {
+			return tz
+		}
+	}
+	return time.UTC
+}
+
+// EventToItem converts a single event to the hebcal.com "items" format.
+func EventToItem(ev event.CalEvent, opts *Options) Item {
+	if opts == nil {
+		opts = &Options{}
+	}
+	locale := opts.locale()
+	hd := ev.GetDate()
+	item := Item{
+		Title:  ev.Render(locale),
+		Hebrew: ev.Render("he-x-nonikud"),
+	}
+	cats := ev.GetCategories()
+	item.Category = cats[0]
+	if len(cats) > 1 {
+		item.Subcat = cats[1]
+	}
+	if timed, ok := ev.(hebcal.TimedEvent); ok {
+		item.Date = timed.EventTime.In(opts.timeZone()).Format(time.RFC3339)
+		item.TitleOrig = timed.Desc
+		if timed.LinkedEvent != nil && (timed.Flags&event.CHANUKAH_CANDLES) == 0 {
+			item.Memo = timed.LinkedEvent.Render(locale)
+		}
+		return item
+	}
+	year, month, day := hd.Greg()
+	item.Date = time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format(isoDate)
+	item.HDate = hd.String()
+	if he, ok := ev.(event.HolidayEvent); ok {
+		item.TitleOrig = he.Desc
+	}
+	item.Link = eventLink(ev, opts.IL)
+	item.Memo = holidayMemo(ev, locale)
+	if (ev.GetFlags() & event.PARSHA_HASHAVUA) != 0 {
+		if l, ok := leyning.GetLeyningOnDate(hd, opts.IL); ok {
+			item.Leyning = leyningMap(l)
+		}
+	} else if he, ok := ev.(event.HolidayEvent); ok {
+		if l, ok := leyning.GetLeyningForHoliday(he, opts.IL); ok {
+			item.Leyning = leyningMap(l)
+		}
+	}
+	return item
+}
+
+// EventsToClassicApi converts events to a complete hebcal.com response.
+func EventsToClassicApi(events []event.CalEvent, opts *Options) Result {
+	if opts == nil {
+		opts = &Options{}
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	title := opts.Title
+	if title == "" {
+		title = "Hebcal"
+	}
+	result := Result{
+		Title: title,
+		Date:  now.Format(time.RFC3339),
+		Items: make([]Item, 0, len(events)),
+	}
+	if loc := opts.Location; loc != nil {
+		result.Location = &LocationInfo{
+			Title:     loc.Name,
+			City:      loc.Name,
+			TZID:      loc.TimeZoneId,
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			CC:        loc.CountryCode,
+			Elevation: loc.Elevation,
+		}
+	}
+	if len(events) != 0 {
+		result.Range = &Range{
+			Start: gregISO(events[0]),
+			End:   gregISO(events[len(events)-1]),
+		}
+	}
+	for _, ev := range events {
+		result.Items = append(result.Items, EventToItem(ev, opts))
+	}
+	return result
+}
+
+// WriteJSON writes events to w as a hebcal.com JSON response.
+func WriteJSON(w io.Writer, events []event.CalEvent, opts *Options) error {
+	return json.NewEncoder(w).Encode(EventsToClassicApi(events, opts))
+}
+
+func gregISO(ev event.CalEvent) string {
+	year, month, day := ev.GetDate().Greg()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format(isoDate)
+}
+
+// holidayMemo returns the translated description of a holiday (for example,
+// "Fast commemorating the siege of Jerusalem" for Asara B'Tevet), falling
+// back to English when the locale has no translation.
+func holidayMemo(ev event.CalEvent, locale string) string {
+	key := "MEMO:" + ev.Basename()
+	if memo, ok := locales.LookupTranslation(key, locale); ok {
+		return memo
+	}
+	if memo, ok := locales.LookupTranslation(key, "en"); ok {
+		return memo
+	}
+	return ""
+}
+
+// noLinkFlags are holiday flags for events that have no hebcal.com page.
+const noLinkFlags = event.SHABBAT_MEVARCHIM | event.YOM_KIPPUR_KATAN
+
+// eventLink returns the hebcal.com page describing a holiday or weekly
+// Torah portion, or "" for other events.
+func eventLink(ev event.CalEvent, il bool) string {
+	year, month, day := ev.GetDate().Greg()
+	if (ev.GetFlags() & event.PARSHA_HASHAVUA) != 0 {
+		url := baseURL + "/sedrot/" + makeAnchor(ev.Basename()) + "-" +
+			time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format("20060102")
+		if il {
+			url += "?i=on"
+		}
+		return url
+	}
+	he, ok := ev.(event.HolidayEvent)
+	if !ok || (he.Flags&noLinkFlags) != 0 {
+		return ""
+	}
+	return baseURL + "/holidays/" + makeAnchor(he.Basename()) + "-" + strconv.Itoa(year)
+}
+
+// leyningMap converts a reading to the hebcal.com "leyning" format.
+func leyningMap(l leyning.Leyning) map[string]string {
+	m := map[string]string{"torah": l.Summary}
+	if l.Maftir.Book != "" {
+		m["maftir"] = l.Maftir.String()
+	}
+	if l.Haftara != "" {
+		m["haftarah"] = l.Haftara
+	}
+	if l.HaftaraSephardic != "" {
+		m["haftarah_sephardic"] = l.HaftaraSephardic
+	}
+	if l.Megillah != "" {
+		m["megillah"] = l.Megillah
+	}
+	for i, aliyah := range l.Aliyot {
+		m[strconv.Itoa(i+1)] = aliyah.String()
+	}
+	return m
+}
+
+var nonAlnum = regexp.MustCompile(`[^a-z0-9]+`)
+
+// makeAnchor converts a title to a URL slug, e.g. "Tish'a B'Av" becomes
+// "tisha-bav", matching the hebcal.com URL scheme.
+func makeAnchor(s string) string {
+	s = strings.ToLower(s)
+	s = strings.NewReplacer("'", "", "’", "").Replace(s)
+	s = nonAlnum.ReplaceAllString(s, "-")
+	return strings.Trim(s, "-")
+}
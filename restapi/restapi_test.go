@@ -0,0 +1,102 @@
+package restapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/restapi"
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventsToClassicApi(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.LookupCity("Chicago")
+	events, err := hebcal.HebrewCalendar(&hebcal.CalOptions{
+		Start:          hdate.New(5782, hdate.Elul, 29),
+		End:            hdate.New(5783, hdate.Tishrei, 1),
+		CandleLighting: true,
+		Location:       loc,
+	})
+	assert.NoError(err)
+	now := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	result := restapi.EventsToClassicApi(events, &restapi.Options{Location: loc, Now: now})
+	assert.Equal("Hebcal", result.Title)
+	assert.Equal("2022-01-01T00:00:00Z", result.Date)
+	assert.Equal("America/Chicago", result.Location.TZID)
+	assert.Equal(&restapi.Range{Start: "2022-09-25", End: "2022-09-26"}, result.Range)
+	expected := []restapi.Item{
+		{
+			Title:     "Erev Rosh Hashana",
+			Date:      "2022-09-25",
+			HDate:     "29 Elul 5782",
+			Category:  "holiday",
+			Subcat:    "major",
+			TitleOrig: "Erev Rosh Hashana",
+			Hebrew:    "ערב ראש השנה",
+			Link:      "https://www.hebcal.com/holidays/rosh-hashana-2022",
+			Memo:      "The Jewish New Year. Also spelled Rosh Hashanah",
+		},
+		{
+			Title:     "Candle lighting: 6:24",
+			Date:      "2022-09-25T18:24:00-05:00",
+			Category:  "candles",
+			TitleOrig: "Candle lighting",
+			Hebrew:    "הדלקת נרות: 6:24",
+			Memo:      "Erev Rosh Hashana",
+		},
+	}
+	assert.Equal(expected, result.Items[:2])
+}
+
+func TestEventToItemParsha(t *testing.T) {
+	assert := assert.New(t)
+	events, _ := hebcal.HebrewCalendar(&hebcal.CalOptions{
+		Start:      hdate.New(5783, hdate.Tishrei, 1),
+		End:        hdate.New(5783, hdate.Tishrei, 30),
+		Sedrot:     true,
+		NoHolidays: true,
+		IL:         true,
+	})
+	item := restapi.EventToItem(events[0], &restapi.Options{IL: true})
+	assert.Equal("Parashat Vayeilech", item.Title)
+	assert.Equal("parashat", item.Category)
+	assert.Equal("https://www.hebcal.com/sedrot/vayeilech-20221001?i=on", item.Link)
+	assert.Equal("Deuteronomy 31:1-30", item.Leyning["torah"])
+	assert.Equal("Deuteronomy 31:1-3", item.Leyning["1"])
+	assert.Equal("Deuteronomy 31:25-30", item.Leyning["7"])
+	assert.Equal("Deuteronomy 31:28-30", item.Leyning["maftir"])
+	// Shabbat Shuva
+	assert.Equal("Hosea 14:2-10; Joel 2:15-27", item.Leyning["haftarah"])
+	assert.Equal("Hosea 14:2-10; Micah 7:18-20", item.Leyning["haftarah_sephardic"])
+}
+
+func TestEventToItemHolidayLeyning(t *testing.T) {
+	assert := assert.New(t)
+	purim := event.HolidayEvent{Date: hdate.New(5783, hdate.Adar1, 14), Desc: "Purim"}
+	item := restapi.EventToItem(purim, nil)
+	assert.Equal("Exodus 17:8-16", item.Leyning["torah"])
+	assert.Equal("Esther 1:1-10:3", item.Leyning["megillah"])
+	assert.NotContains(item.Leyning, "haftarah")
+	assert.NotContains(item.Leyning, "maftir")
+}
+
+func TestWriteJSON(t *testing.T) {
+	assert := assert.New(t)
+	events, _ := hebcal.HebrewCalendar(&hebcal.CalOptions{Year: 2023, Month: time.March})
+	var buf bytes.Buffer
+	err := restapi.WriteJSON(&buf, events, &restapi.Options{Locale: "he"})
+	assert.NoError(err)
+	var m map[string]interface{}
+	assert.NoError(json.Unmarshal(buf.Bytes(), &m))
+	items := m["items"].([]interface{})
+	assert.Equal(len(events), len(items))
+	first := items[0].(map[string]interface{})
+	assert.Equal("2023-03-04", first["date"])
+	assert.NotContains(first, "location")
+}
@@ -0,0 +1,256 @@
+package server
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+// isOn reports whether a hebcal.com boolean query parameter is set.
+// hebcal.com uses "on", but "1" and "true" are accepted too.
+func isOn(q url.Values, key string) bool {
+	switch strings.ToLower(q.Get(key)) {
+	case "on", "1", "true", "yes":
+		return true
+	}
+	return false
+}
+
+// queryFlags maps the hebcal.com event-type query parameters to the
+// holiday flags they select.
+var queryFlags = []struct {
+	key   string
+	flags event.HolidayFlags
+}{
+	{"maj", event.CHAG | event.LIGHT_CANDLES | event.LIGHT_CANDLES_TZEIS |
+		event.YOM_TOV_ENDS | event.CHOL_HAMOED | event.EREV | event.MAJOR_FAST},
+	{"min", event.MINOR_HOLIDAY | event.CHANUKAH_CANDLES},
+	{"mod", event.MODERN_HOLIDAY},
+	{"nx", event.ROSH_CHODESH},
+	{"ss", event.SPECIAL_SHABBAT},
+	{"mf", event.MINOR_FAST},
+	{"s", event.PARSHA_HASHAVUA},
+	{"o", event.OMER_COUNT},
+	{"F", event.DAF_YOMI},
+	{"myomi", event.MISHNA_YOMI},
+	{"nyomi", event.NACH_YOMI},
+	{"yyomi", event.YERUSHALMI_YOMI},
+	{"ykk", event.YOM_KIPPUR_KATAN},
+}
+
+// holidayFlags are the flags selected by the holiday query parameters
+// (maj, min, mod, nx, ss and mf), as opposed to Torah readings and daily
+// learning. Without any of them, holidays are suppressed entirely.
+const holidayFlags = event.CHAG | event.LIGHT_CANDLES | event.LIGHT_CANDLES_TZEIS |
+	event.YOM_TOV_ENDS | event.CHOL_HAMOED | event.EREV | event.MAJOR_FAST |
+	event.MINOR_HOLIDAY | event.CHANUKAH_CANDLES | event.MODERN_HOLIDAY |
+	event.ROSH_CHODESH | event.SPECIAL_SHABBAT | event.MINOR_FAST
+
+// localeFromQuery maps the hebcal.com "lg" parameter to a locale. The
+// one-letter codes are hebcal.com's historical names; any other value is
+// used as a locale name directly.
+func localeFromQuery(q url.Values) string {
+	switch lg := q.Get("lg"); lg {
+	case "", "s":
+		return "en"
+	case "a":
+		return "ashkenazi"
+	case "h":
+		return "he"
+	default:
+		return lg
+	}
+}
+
+// LocationFromQuery returns the location described by the "city" (a
+// classic city name such as "Chicago") or the "latitude", "longitude" and
+// "tzid" query parameters, or nil if none are present. Lookups that
+// require a database, such as "geonameid" and "zip", are not supported.
+func LocationFromQuery(q url.Values) (*zmanim.Location, error) {
+	if q.Get("geonameid") != "" || q.Get("zip") != "" {
+		return nil, errors.New("geonameid and zip are not supported; use latitude, longitude and tzid")
+	}
+	if city := q.Get("city"); city != "" {
+		loc := zmanim.LookupCity(city)
+		if loc == nil {
+			return nil, fmt.Errorf("unknown city %q", city)
+		}
+		return loc, nil
+	}
+	latStr, longStr, tzid := q.Get("latitude"), q.Get("longitude"), q.Get("tzid")
+	if latStr == "" && longStr == "" {
+		return nil, nil
+	}
+	lat, err := strconv.ParseFloat(latStr, 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return nil, fmt.Errorf("invalid latitude %q", latStr)
+	}
+	long, err := strconv.ParseFloat(longStr, 64)
+	if err != nil || long < -180 || long > 180 {
+		return nil, fmt.Errorf("invalid longitude %q", longStr)
+	}
+	if tzid == "" {
+		return nil, errors.New("latitude and longitude require tzid")
+	}
+	if _, err := zmanim.LoadLocation(tzid); err != nil {
+		return nil, fmt.Errorf("invalid tzid %q", tzid)
+	}
+	elevation := 0
+	if elev := q.Get("elev"); elev != "" {
+		elevation, err = strconv.Atoi(elev)
+		if err != nil {
+			return nil, fmt.Errorf("invalid elev %q", elev)
+		}
+	}
+	name := q.Get("city-name")
+	if name == "" {
+		name = latStr + ", " + longStr
+	}
+	loc := zmanim.NewLocation(name, "", lat, long, elevation, tzid)
+	return &loc, nil
+}
+
+// CalOptionsFromQuery converts the query parameters of a hebcal.com
+// /hebcal request into CalOptions. now is used to resolve "year=now" and a
+// missing year.
+//
+// Event types are selected with maj, min, mod, nx, ss, mf, s, o and F.
+// The date range is given by year (Gregorian, or Hebrew with yt=H), an
+// optional month (1-12, or "x" for the whole year), or explicit start and
+// end dates in YYYY-MM-DD format spanning at most about three years.
+// Candle-lighting (c=on) requires a
+// location and honors b (minutes before sunset), m (minutes after sunset
+// for Havdalah) and M=on (Havdalah at nightfall).
+func CalOptionsFromQuery(q url.Values, now time.Time) (*hebcal.CalOptions, error) {
+	opts := &hebcal.CalOptions{
+		IL:                      isOn(q, "i"),
+		CandleLighting:          isOn(q, "c"),
+		AddHebrewDates:          isOn(q, "d"),
+		AddHebrewDatesForEvents: isOn(q, "D"),
+		Molad:                   isOn(q, "molad"),
+		UseElevation:            isOn(q, "ue"),
+	}
+	for _, qf := range queryFlags {
+		if isOn(q, qf.key) {
+			opts.Mask |= qf.flags
+		}
+	}
+	opts.NoHolidays = (opts.Mask & holidayFlags) == 0
+	if opts.CandleLighting {
+		opts.Mask |= event.LIGHT_CANDLES | event.LIGHT_CANDLES_TZEIS | event.YOM_TOV_ENDS
+	}
+	loc, err := LocationFromQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	opts.Location = loc
+	if opts.CandleLighting {
+		if loc == nil {
+			return nil, errors.New("c=on requires a location")
+		}
+		if opts.CandleLightingMins, err = intParam(q, "b"); err != nil {
+			return nil, err
+		}
+		if !isOn(q, "M") {
+			if opts.HavdalahMins, err = intParam(q, "m"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := setRangeFromQuery(opts, q, now); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+func intParam(q url.Values, key string) (int, error) {
+	str := q.Get(key)
+	if str == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", key, str)
+	}
+	return n, nil
+}
+
+func dateParam(q url.Values, key string) (hdate.HDate, error) {
+	t, err := time.Parse("2006-01-02", q.Get(key))
+	if err != nil {
+		return hdate.HDate{}, fmt.Errorf("invalid %s %q", key, q.Get(key))
+	}
+	return hdate.FromGregorian(t.Year(), t.Month(), t.Day()), nil
+}
+
+// maxRangeDays limits the span of a start/end query, so that a single
+// request cannot generate events for thousands of years.
+const maxRangeDays = 3 * 366
+
+func setRangeFromQuery(opts *hebcal.CalOptions, q url.Values, now time.Time) error {
+	if q.Get("start") != "" || q.Get("end") != "" {
+		start, err := dateParam(q, "start")
+		if err != nil {
+			return err
+		}
+		end, err := dateParam(q, "end")
+		if err != nil {
+			return err
+		}
+		if end.Abs() < start.Abs() {
+			return errors.New("end is before start")
+		}
+		if end.Abs()-start.Abs() >= maxRangeDays {
+			return fmt.Errorf("start to end range exceeds %d days", maxRangeDays)
+		}
+		opts.Start, opts.End = start, end
+		return nil
+	}
+	opts.IsHebrewYear = q.Get("yt") == "H"
+	switch year := q.Get("year"); year {
+	case "", "now":
+		if opts.IsHebrewYear {
+			opts.Year = hdate.FromTime(now).Year()
+		} else {
+			opts.Year = now.Year()
+		}
+	default:
+		n, err := strconv.Atoi(year)
+		if err != nil || n == 0 {
+			return fmt.Errorf("invalid year %q", year)
+		}
+		opts.Year = n
+	}
+	if month := q.Get("month"); month != "" && month != "x" && !opts.IsHebrewYear {
+		n, err := strconv.Atoi(month)
+		if err != nil || n < 1 || n > 12 {
+			return fmt.Errorf("invalid month %q", month)
+		}
+		opts.Month = time.Month(n)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package server_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalOptionsFromQuery(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2022, time.September, 21, 15, 0, 0, 0, time.UTC)
+	q, _ := url.ParseQuery("v=1&maj=on&nx=on&s=on&c=on&b=40&m=50&i=on&latitude=31.76904&longitude=35.21633&tzid=Asia/Jerusalem&year=now&month=x")
+	opts, err := server.CalOptionsFromQuery(q, now)
+	assert.NoError(err)
+	assert.Equal(2022, opts.Year)
+	assert.Equal(time.Month(0), opts.Month)
+	assert.True(opts.IL)
+	assert.True(opts.CandleLighting)
+	assert.False(opts.NoHolidays)
+	assert.Equal(40, opts.CandleLightingMins)
+	assert.Equal(50, opts.HavdalahMins)
+	assert.Equal("Asia/Jerusalem", opts.Location.TimeZoneId)
+	assert.NotZero(opts.Mask & event.ROSH_CHODESH)
+	assert.NotZero(opts.Mask & event.PARSHA_HASHAVUA)
+	assert.Zero(opts.Mask & event.MODERN_HOLIDAY)
+
+	q, _ = url.ParseQuery("v=1&s=on&yt=H")
+	opts, err = server.CalOptionsFromQuery(q, now)
+	assert.NoError(err)
+	assert.True(opts.NoHolidays)
+	assert.True(opts.IsHebrewYear)
+	assert.Equal(5782, opts.Year)
+
+	for query, msg := range map[string]string{
+		"latitude=41&longitude=-87":       "latitude and longitude require tzid",
+		"latitude=100&longitude=-87":      `invalid latitude "100"`,
+		"city=Atlantis":                   `unknown city "Atlantis"`,
+		"year=2022&month=13":              `invalid month "13"`,
+		"start=2022-09-01&end=2022-08-01": "end is before start",
+		"start=0001-01-01&end=9999-12-31": "start to end range exceeds 1098 days",
+	} {
+		q, _ = url.ParseQuery(query)
+		_, err = server.CalOptionsFromQuery(q, now)
+		assert.EqualError(err, msg, query)
+	}
+}
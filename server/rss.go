@@ -0,0 +1,110 @@
+package server
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/restapi"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link,omitempty"`
+	GUID        rssGUID `xml:"guid"`
+	Description string  `xml:"description,omitempty"`
+	Category    string  `xml:"category"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+// writeRSS writes events as an RSS 2.0 feed. Each item is built from the
+// same fields as the JSON "items" format.
+func writeRSS(w io.Writer, title string, events []event.CalEvent, opts *restapi.Options, now time.Time) error {
+	tz := time.UTC
+	if opts.Location != nil {
+		if loc, err := zmanim.LoadLocation(opts.Location.TimeZoneId); err == nil {
+			tz = loc
+		}
+	}
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         title,
+			Link:          "https://www.hebcal.com/",
+			Description:   title,
+			Language:      opts.Locale,
+			LastBuildDate: now.Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(events)),
+		},
+	}
+	if feed.Channel.Language == "" {
+		feed.Channel.Language = "en"
+	}
+	for _, ev := range events {
+		item := restapi.EventToItem(ev, opts)
+		var pubDate time.Time
+		if timed, ok := ev.(hebcal.TimedEvent); ok {
+			pubDate = timed.EventTime.In(tz)
+		} else {
+			year, month, day := ev.GetDate().Greg()
+			pubDate = time.Date(year, month, day, 0, 0, 0, 0, tz)
+		}
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{Value: item.Date + "-" + ev.Basename()},
+			Description: item.Memo,
+			Category:    item.Category,
+			PubDate:     pubDate.Format(time.RFC1123Z),
+		})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(feed); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,293 @@
+// Hebcal's server package implements the hebcal.com /hebcal, /shabbat and
+// /converter REST endpoints as a net/http Handler, so that applications
+// which call hebcal.com can be served entirely from this library.
+//
+// Only the machine-readable responses are provided (JSON, iCalendar, CSV
+// and RSS); the HTML pages of hebcal.com are not. Locations are given
+// with latitude, longitude and tzid, or with one of the classic city names
+// known to zmanim.LookupCity.
+package server
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/event"
+	"github.com/hebcal/hebcal-go/hebcal"
+	"github.com/hebcal/hebcal-go/icalendar"
+	"github.com/hebcal/hebcal-go/restapi"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+// Handler serves the /hebcal, /shabbat and /converter endpoints. The zero
+// value is ready to use:
+//
+//	http.Handle("/", &server.Handler{})
+type Handler struct {
+	// Now returns the current time, used when a request does not specify
+	// a date. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+func (h *Handler) now() time.Time {
+	if h.Now != nil {
+		return h.Now()
+	}
+	return time.Now()
+}
+
+// ServeHTTP dispatches a request to the endpoint named by its path.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	q := r.URL.Query()
+	var err error
+	switch r.URL.Path {
+	case "/hebcal", "/hebcal/":
+		err = h.serveHebcal(w, q)
+	case "/shabbat", "/shabbat/":
+		err = h.serveShabbat(w, q)
+	case "/converter", "/converter/":
+		err = h.serveConverter(w, q)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown endpoint %s", r.URL.Path))
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+	}
+}
+
+// writeError responds with a hebcal.com style {"error": "..."} body.
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+// writeEvents renders events in the format selected by the "cfg" query
+// parameter: json (the default), ics, csv or rss. Errors writing the
+// response are not reported, since the status has already been sent.
+func writeEvents(w http.ResponseWriter, q url.Values, title string, events []event.CalEvent, opts *restapi.Options) error {
+	switch cfg := q.Get("cfg"); cfg {
+	case "", "json":
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		restapi.WriteJSON(w, events, opts)
+	case "ics":
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		icalendar.EventsToIcalendar(w, events, &icalendar.Options{
+			Location: opts.Location,
+			Locale:   opts.Locale,
+			Title:    title,
+			Emoji:    isOn(q, "emoji"),
+		})
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="hebcal.csv"`)
+		restapi.WriteCSV(w, events, opts)
+	case "rss":
+		w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+		writeRSS(w, title, events, opts, opts.Now)
+	default:
+		return fmt.Errorf("unknown cfg %q", cfg)
+	}
+	return nil
+}
+
+func (h *Handler) serveHebcal(w http.ResponseWriter, q url.Values) error {
+	now := h.now()
+	calOpts, err := CalOptionsFromQuery(q, now)
+	if err != nil {
+		return err
+	}
+	events, err := hebcal.HebrewCalendar(calOpts)
+	if err != nil {
+		return err
+	}
+	title := "Hebcal"
+	if calOpts.Location != nil {
+		title += " " + calOpts.Location.Name
+	}
+	opts := &restapi.Options{
+		Locale:    localeFromQuery(q),
+		Location:  calOpts.Location,
+		IL:        calOpts.IL,
+		Title:     title,
+		Now:       now,
+		EuroDates: isOn(q, "euro"),
+	}
+	return writeEvents(w, q, title, events, opts)
+}
+
+// serveShabbat answers with candle-lighting, Havdalah, the weekly Torah
+// portion and any holidays from the requested date (default today)
+// through the following Saturday. On a Saturday, the preceding Friday is
+// included so that the candle-lighting time is not lost.
+func (h *Handler) serveShabbat(w http.ResponseWriter, q url.Values) error {
+	loc, err := LocationFromQuery(q)
+	if err != nil {
+		return err
+	}
+	if loc == nil {
+		return errors.New("a location is required")
+	}
+	tz, err := zmanim.LoadLocation(loc.TimeZoneId)
+	if err != nil {
+		return err
+	}
+	now := h.now()
+	today, err := dateFromQuery(q, now.In(tz))
+	if err != nil {
+		return err
+	}
+	start := today
+	if start.Weekday() == time.Saturday {
+		start = start.Prev()
+	}
+	calOpts := &hebcal.CalOptions{
+		Start:          start,
+		End:            today.OnOrAfter(time.Saturday),
+		Location:       loc,
+		CandleLighting: true,
+		Sedrot:         true,
+		IL:             isOn(q, "i") || loc.CountryCode == "IL",
+	}
+	if calOpts.CandleLightingMins, err = intParam(q, "b"); err != nil {
+		return err
+	}
+	if !isOn(q, "M") {
+		if calOpts.HavdalahMins, err = intParam(q, "m"); err != nil {
+			return err
+		}
+	}
+	events, err := hebcal.HebrewCalendar(calOpts)
+	if err != nil {
+		return err
+	}
+	title := "Hebcal " + loc.Name
+	opts := &restapi.Options{
+		Locale:   localeFromQuery(q),
+		Location: loc,
+		IL:       calOpts.IL,
+		Title:    title,
+		Now:      now,
+	}
+	return writeEvents(w, q, title, events, opts)
+}
+
+// dateFromQuery returns the Gregorian date given by the gy, gm and gd
+// query parameters, or the date of now if they are absent.
+func dateFromQuery(q url.Values, now time.Time) (hdate.HDate, error) {
+	if q.Get("gy") == "" && q.Get("gm") == "" && q.Get("gd") == "" {
+		return hdate.FromGregorian(now.Year(), now.Month(), now.Day()), nil
+	}
+	gy, err1 := strconv.Atoi(q.Get("gy"))
+	gm, err2 := strconv.Atoi(q.Get("gm"))
+	gd, err3 := strconv.Atoi(q.Get("gd"))
+	if err1 != nil || err2 != nil || err3 != nil || gy == 0 || gm < 1 || gm > 12 || gd < 1 ||
+		gd > time.Date(gy, time.Month(gm)+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return hdate.HDate{}, fmt.Errorf("invalid date gy=%q gm=%q gd=%q", q.Get("gy"), q.Get("gm"), q.Get("gd"))
+	}
+	return hdate.FromGregorian(gy, time.Month(gm), gd), nil
+}
+
+// ConverterResult is the JSON response of the /converter endpoint.
+type ConverterResult struct {
+	GY          int      `json:"gy"`
+	GM          int      `json:"gm"`
+	GD          int      `json:"gd"`
+	AfterSunset bool     `json:"afterSunset"`
+	HY          int      `json:"hy"`
+	HM          string   `json:"hm"`
+	HD          int      `json:"hd"`
+	Hebrew      string   `json:"hebrew"`
+	Events      []string `json:"events"`
+}
+
+// serveConverter converts a Gregorian date (gy, gm, gd; gs=on for after
+// sunset) to a Hebrew date, or with h2g=1 a Hebrew date (hy, hm, hd) to a
+// Gregorian date.
+func (h *Handler) serveConverter(w http.ResponseWriter, q url.Values) error {
+	if cfg := q.Get("cfg"); cfg != "" && cfg != "json" {
+		return fmt.Errorf("unknown cfg %q", cfg)
+	}
+	var hd hdate.HDate
+	afterSunset := false
+	if isOn(q, "h2g") {
+		hy, err := strconv.Atoi(q.Get("hy"))
+		if err != nil || hy < 1 {
+			return fmt.Errorf("invalid hy %q", q.Get("hy"))
+		}
+		hm, err := hdate.MonthFromName(q.Get("hm"))
+		if err != nil {
+			return fmt.Errorf("invalid hm %q", q.Get("hm"))
+		}
+		day, err := strconv.Atoi(q.Get("hd"))
+		if err != nil || day < 1 || day > hdate.DaysInMonth(hm, hy) {
+			return fmt.Errorf("invalid hd %q", q.Get("hd"))
+		}
+		hd = hdate.New(hy, hm, day)
+	} else {
+		var err error
+		hd, err = dateFromQuery(q, h.now())
+		if err != nil {
+			return err
+		}
+		if isOn(q, "gs") {
+			afterSunset = true
+			hd = hd.Next()
+		}
+	}
+	gdate := hd
+	if afterSunset {
+		gdate = hd.Prev()
+	}
+	gy, gm, gd := gdate.Greg()
+	locale := localeFromQuery(q)
+	result := ConverterResult{
+		GY:          gy,
+		GM:          int(gm),
+		GD:          gd,
+		AfterSunset: afterSunset,
+		HY:          hd.Year(),
+		HM:          hd.MonthName("en"),
+		HD:          hd.Day(),
+		Hebrew:      event.NewHebrewDateEvent(hd).Render("he"),
+		Events:      []string{},
+	}
+	for _, ev := range hebcal.GetHolidaysOnDate(hd, isOn(q, "i")) {
+		// Yom Kippur Katan is not one of the default holidays.
+		if (ev.Flags & event.YOM_KIPPUR_KATAN) != 0 {
+			continue
+		}
+		result.Events = append(result.Events, ev.Render(locale))
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(result)
+	return nil
+}
@@ -0,0 +1,143 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hebcal/hebcal-go/restapi"
+	"github.com/hebcal/hebcal-go/server"
+	"github.com/stretchr/testify/assert"
+)
+
+var handler = &server.Handler{
+	Now: func() time.Time { return time.Date(2022, time.September, 21, 15, 0, 0, 0, time.UTC) },
+}
+
+func get(t *testing.T, url string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHebcalJSON(t *testing.T) {
+	assert := assert.New(t)
+	rec := get(t, "/hebcal?v=1&cfg=json&maj=on&c=on&city=Chicago&start=2022-09-25&end=2022-09-26")
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+	var result restapi.Result
+	assert.NoError(json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal("Hebcal Chicago", result.Title)
+	titles := []string{}
+	for _, item := range result.Items {
+		titles = append(titles, item.Title)
+	}
+	assert.Equal([]string{
+		"Erev Rosh Hashana",
+		"Candle lighting: 6:24",
+		"Rosh Hashana 5783",
+		"Candle lighting: 7:22",
+	}, titles)
+}
+
+func TestHebcalEventTypes(t *testing.T) {
+	assert := assert.New(t)
+	rec := get(t, "/hebcal?v=1&cfg=json&s=on&year=2022&month=10&lg=h")
+	var result restapi.Result
+	assert.NoError(json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(4, len(result.Items))
+	for _, item := range result.Items {
+		assert.Equal("parashat", item.Category)
+	}
+	assert.Equal("פָּרָשַׁת וַיֵּלֶךְ", result.Items[0].Title)
+
+	rec = get(t, "/hebcal?v=1&cfg=json&mf=on&year=5783&yt=H")
+	assert.NoError(json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal([]string{"Tzom Gedaliah", "Asara B'Tevet", "Ta'anit Esther", "Ta'anit Bechorot", "Tzom Tammuz"},
+		itemTitles(result))
+}
+
+func itemTitles(result restapi.Result) []string {
+	titles := []string{}
+	for _, item := range result.Items {
+		titles = append(titles, item.Title)
+	}
+	return titles
+}
+
+func TestHebcalFormats(t *testing.T) {
+	assert := assert.New(t)
+	rec := get(t, "/hebcal?v=1&cfg=ics&maj=on&year=2022&month=9")
+	assert.Equal("text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.True(strings.HasPrefix(rec.Body.String(), "BEGIN:VCALENDAR\r\n"))
+	rec = get(t, "/hebcal?v=1&cfg=csv&maj=on&year=2022&month=9")
+	assert.True(strings.HasPrefix(rec.Body.String(), restapi.CsvHeader+"\r\n"))
+	rec = get(t, "/hebcal?v=1&cfg=rss&maj=on&year=2022&month=9")
+	assert.Contains(rec.Body.String(), "<title>Erev Rosh Hashana</title>")
+	assert.Contains(rec.Body.String(), "<pubDate>Sun, 25 Sep 2022 00:00:00 +0000</pubDate>")
+}
+
+func TestHebcalErrors(t *testing.T) {
+	assert := assert.New(t)
+	rec := get(t, "/hebcal?v=1&cfg=json&c=on")
+	assert.Equal(http.StatusBadRequest, rec.Code)
+	assert.JSONEq(`{"error":"c=on requires a location"}`, rec.Body.String())
+	rec = get(t, "/hebcal?v=1&cfg=xml&maj=on")
+	assert.JSONEq(`{"error":"unknown cfg \"xml\""}`, rec.Body.String())
+	rec = get(t, "/hebcal?v=1&geonameid=4887398")
+	assert.Equal(http.StatusBadRequest, rec.Code)
+	rec = get(t, "/hebcal?v=1&cfg=json&maj=on&start=0001-01-01&end=9999-12-31")
+	assert.Equal(http.StatusBadRequest, rec.Code)
+	assert.JSONEq(`{"error":"start to end range exceeds 1098 days"}`, rec.Body.String())
+	rec = get(t, "/hebcal?v=1&cfg=json&maj=on&start=2022-01-01&end=2024-12-31")
+	assert.Equal(http.StatusOK, rec.Code)
+	rec = get(t, "/nope")
+	assert.Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestShabbat(t *testing.T) {
+	assert := assert.New(t)
+	rec := get(t, "/shabbat?cfg=json&latitude=41.85003&longitude=-87.65005&tzid=America/Chicago&city-name=Chicago")
+	assert.Equal(http.StatusOK, rec.Code)
+	var result restapi.Result
+	assert.NoError(json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(&restapi.Range{Start: "2022-09-23", End: "2022-09-24"}, result.Range)
+	assert.Equal([]string{
+		"Candle lighting: 6:27",
+		"Parashat Nitzavim",
+		"Havdalah: 7:25",
+	}, itemTitles(result))
+	assert.Equal("Chicago", result.Location.City)
+}
+
+func TestConverter(t *testing.T) {
+	assert := assert.New(t)
+	rec := get(t, "/converter?cfg=json&gy=2011&gm=6&gd=1&g2h=1")
+	var result server.ConverterResult
+	assert.NoError(json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(server.ConverterResult{
+		GY: 2011, GM: 6, GD: 1,
+		HY: 5771, HM: "Iyyar", HD: 28,
+		Hebrew: "כ״ח אִיָיר תשע״א",
+		Events: []string{"Yom Yerushalayim"},
+	}, result)
+
+	rec = get(t, "/converter?cfg=json&gy=2011&gm=6&gd=1&gs=on")
+	result = server.ConverterResult{}
+	assert.NoError(json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.True(result.AfterSunset)
+	assert.Equal(1, result.GD)
+	assert.Equal(29, result.HD)
+	assert.Equal([]string{}, result.Events)
+
+	rec = get(t, "/converter?cfg=json&h2g=1&hy=5783&hm=Tishrei&hd=10")
+	result = server.ConverterResult{}
+	assert.NoError(json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal([]int{2022, 10, 5}, []int{result.GY, result.GM, result.GD})
+	assert.Equal([]string{"Yom Kippur"}, result.Events)
+}
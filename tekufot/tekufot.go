@@ -0,0 +1,205 @@
+// Hebcal's tekufot package calculates the four tekufot (seasons) of the
+// year according to Shmuel and according to Rav Adda.
+//
+// A tekufa is traditionally announced in the local mean time of Jerusalem
+// (JMT). The times returned by this package are absolute instants; use
+// Tekufa.TimeIn or time.Time.In to display them in a particular time zone.
+//
+// The calculations follow the Rambam, Hilchot Kiddush HaChodesh chapters
+// 9 and 10.
+package tekufot
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/hebcal/hebcal-go/molad"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+// Opinion selects the length of the solar year used to calculate the
+// tekufot.
+type Opinion int
+
+const (
+	// Shmuel's year of 365¼ days. This is the opinion used in practice
+	// for V'ten Tal u'Matar and Birkat HaChama.
+	Shmuel Opinion = 1 + iota
+	// Rav Adda's year of 365 days, 5 hours, 997 chalakim and 48 regaim,
+	// which keeps the tekufot in step with the 19-year lunar cycle.
+	RavAdda
+)
+
+// Times are calculated in regaim (moments) to keep Rav Adda's arithmetic
+// exact. A chelek is 76 regaim and an hour is 1080 chalakim.
+const (
+	regaimPerChelek = 76
+	regaimPerHour   = 1080 * regaimPerChelek
+	regaimPerDay    = 24 * regaimPerHour
+)
+
+// Shmuel: 91 days and 7½ hours between tekufot.
+const shmuelTekufa int64 = 91*regaimPerDay + 7*regaimPerHour + regaimPerHour/2
+
+// Rav Adda: 91 days, 7 hours, 519 chalakim and 31 regaim between tekufot.
+const ravAddaTekufa int64 = 91*regaimPerDay + 7*regaimPerHour + 519*regaimPerChelek + 31
+
+// According to Shmuel, Tekufat Nisan of the first year of creation fell
+// at the beginning of the night of R.D. -1373257 (Wednesday), the same
+// weekday and hour to which it returns every 28 years for Birkat HaChama.
+const shmuelEpoch int64 = -1373257*regaimPerDay - 6*regaimPerHour
+
+// According to Rav Adda, Tekufat Nisan of the first year of each 19-year
+// cycle falls 9 hours and 642 chalakim before the molad of Nisan.
+const ravAddaMoladOffset int64 = 9*regaimPerHour + 642*regaimPerChelek
+
+// Jerusalem mean time is 2:20:56.496 ahead of UTC (see the molad package).
+const jerusalemMeanTimeOffset = 2*time.Hour + 20*time.Minute + 56*time.Second + 496*time.Millisecond
+
+// R.D. 719163 is January 1, 1970.
+const unixEpochRD = 719163
+
+// Tekufa is the moment of one of the four seasons of the year.
+type Tekufa struct {
+	Month   hdate.HMonth // Nisan, Tammuz, Tishrei or Tevet
+	Opinion Opinion
+	Time    time.Time // in UTC
+	regaim  int64     // JMT, since midnight at the start of R.D. 0
+}
+
+// New returns the Tekufa of month (Nisan, Tammuz, Tishrei or Tevet) for
+// Hebrew year year. Tekufat Tishrei and Tekufat Tevet are those that
+// precede Tekufat Nisan of the same year, so that they fall around the
+// months for which they are named. (According to Rav Adda, Tekufat Tishrei
+// often falls at the end of Elul of the previous year.) The zero Opinion
+// is treated as Shmuel.
+func New(year int, month hdate.HMonth, opinion Opinion) (Tekufa, error) {
+	var quarters int64
+	switch month {
+	case hdate.Tishrei:
+		quarters = -2
+	case hdate.Tevet:
+		quarters = -1
+	case hdate.Nisan:
+		quarters = 0
+	case hdate.Tamuz:
+		quarters = 1
+	default:
+		return Tekufa{}, fmt.Errorf("there is no tekufa in month %s", month)
+	}
+	if year < 1 {
+		return Tekufa{}, fmt.Errorf("invalid Hebrew year %d", year)
+	}
+	var regaim int64
+	switch opinion {
+	case 0, Shmuel:
+		opinion = Shmuel
+		regaim = shmuelEpoch + int64(year-1)*4*shmuelTekufa + quarters*shmuelTekufa
+	case RavAdda:
+		yearOfCycle := int64((year - 1) % 19)
+		m := molad.New(year-int(yearOfCycle), hdate.Nisan)
+		moladRegaim := m.Date.Abs()*regaimPerDay + int64(m.Hours)*regaimPerHour +
+			int64(m.Minutes*18+m.Chalakim)*regaimPerChelek
+		regaim = moladRegaim - ravAddaMoladOffset + (4*yearOfCycle+quarters)*ravAddaTekufa
+	default:
+		return Tekufa{}, fmt.Errorf("unknown opinion %d", opinion)
+	}
+	return Tekufa{
+		Month:   month,
+		Opinion: opinion,
+		Time:    regaimToTime(regaim),
+		regaim:  regaim,
+	}, nil
+}
+
+// ForYear returns the four tekufot of Hebrew year year in chronological
+// order: Tishrei, Tevet, Nisan and Tammuz.
+func ForYear(year int, opinion Opinion) ([]Tekufa, error) {
+	months := []hdate.HMonth{hdate.Tishrei, hdate.Tevet, hdate.Nisan, hdate.Tamuz}
+	result := make([]Tekufa, len(months))
+	for i, month := range months {
+		t, err := New(year, month, opinion)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = t
+	}
+	return result, nil
+}
+
+// regaimToTime converts a count of regaim in Jerusalem mean time to an
+// instant in UTC.
+func regaimToTime(regaim int64) time.Time {
+	days := regaim / regaimPerDay
+	rem := regaim % regaimPerDay
+	if rem < 0 {
+		days--
+		rem += regaimPerDay
+	}
+	nsec := rem * int64(time.Hour) / regaimPerHour
+	jmt := time.Unix((days-unixEpochRD)*86400, nsec).UTC()
+	return jmt.Add(-jerusalemMeanTimeOffset)
+}
+
+// TimeIn returns the moment of the tekufa in the time zone of loc.
+func (t Tekufa) TimeIn(loc *zmanim.Location) (time.Time, error) {
+	tz, err := zmanim.LoadLocation(loc.TimeZoneId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.Time.In(tz), nil
+}
+
+// HDate returns the Hebrew date on which the tekufa falls. Since the
+// Hebrew day begins at nightfall, a tekufa in the evening (6pm or later,
+// Jerusalem mean time) belongs to the following date.
+func (t Tekufa) HDate() hdate.HDate {
+	day := (t.regaim + 6*regaimPerHour) / regaimPerDay
+	if (t.regaim+6*regaimPerHour)%regaimPerDay < 0 {
+		day--
+	}
+	return hdate.FromRD(day)
+}
+
+// String returns the name of the tekufa, e.g. "Tekufat Tishrei".
+func (t Tekufa) String() string {
+	return "Tekufat " + t.Month.String()
+}
+
+// TalUMatarStart returns the Hebrew date on which V'ten Tal u'Matar (the
+// request for rain in the weekday Amidah) is first said in Hebrew year
+// year. As with every Hebrew date, it begins at nightfall, so the first
+// recitation is at Maariv on the evening before.
+//
+// In Israel this is 7 Cheshvan. In the Diaspora it is the 60th day after
+// Tekufat Tishrei according to Shmuel, counting the day of the tekufa as
+// the first; if that day is Shabbat, which has no weekday Amidah, it is
+// the following day.
+func TalUMatarStart(year int, il bool) hdate.HDate {
+	if il {
+		return hdate.New(year, hdate.Cheshvan, 7)
+	}
+	t, _ := New(year, hdate.Tishrei, Shmuel)
+	hd := hdate.FromRD(t.HDate().Abs() + 59)
+	if hd.Weekday() == time.Saturday {
+		hd = hd.Next()
+	}
+	return hd
+}
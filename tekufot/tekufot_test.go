@@ -0,0 +1,99 @@
+package tekufot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hdate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hebcal/hebcal-go/molad"
+	"github.com/hebcal/hebcal-go/tekufot"
+	"github.com/hebcal/hebcal-go/zmanim"
+)
+
+func TestShmuelBirkatHaChama(t *testing.T) {
+	assert := assert.New(t)
+	// Birkat HaChama was recited on Wednesday morning, April 8, 2009;
+	// Tekufat Nisan fell at 6pm JMT the evening before.
+	tk, err := tekufot.New(5769, hdate.Nisan, tekufot.Shmuel)
+	assert.NoError(err)
+	assert.Equal("Tekufat Nisan", tk.String())
+	assert.Equal(time.Date(2009, time.April, 7, 15, 39, 3, 504000000, time.UTC), tk.Time)
+	assert.Equal(hdate.New(5769, hdate.Nisan, 14), tk.HDate())
+	assert.Equal(time.Wednesday, tk.HDate().Weekday())
+}
+
+func TestForYear(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.LookupCity("Jerusalem")
+	expected := map[tekufot.Opinion][]string{
+		tekufot.Shmuel: {
+			"Tekufat Tishrei 2022-10-07 15:39",
+			"Tekufat Tevet 2023-01-06 22:09",
+			"Tekufat Nisan 2023-04-08 06:39",
+			"Tekufat Tammuz 2023-07-08 14:09",
+		},
+		tekufot.RavAdda: {
+			"Tekufat Tishrei 2022-09-26 06:42",
+			"Tekufat Tevet 2022-12-26 13:11",
+			"Tekufat Nisan 2023-03-27 21:40",
+			"Tekufat Tammuz 2023-06-27 05:09",
+		},
+	}
+	for opinion, want := range expected {
+		all, err := tekufot.ForYear(5783, opinion)
+		assert.NoError(err)
+		actual := make([]string, 0, len(all))
+		for _, tk := range all {
+			assert.Equal(opinion, tk.Opinion)
+			local, err := tk.TimeIn(loc)
+			assert.NoError(err)
+			actual = append(actual, tk.String()+" "+local.Format("2006-01-02 15:04"))
+		}
+		assert.Equal(want, actual)
+	}
+}
+
+func TestRavAddaCycle(t *testing.T) {
+	assert := assert.New(t)
+	// Rav Adda's 19 solar years equal 235 lunar months exactly, so Tekufat
+	// Nisan keeps the same distance from the molad in every cycle.
+	for _, year := range []int{5701, 5720, 5739, 5758, 5777, 5796} {
+		tk, err := tekufot.New(year, hdate.Nisan, tekufot.RavAdda)
+		assert.NoError(err)
+		m := molad.New(year, hdate.Nisan)
+		diff := m.Time().Sub(tk.Time)
+		assert.Equal(9*time.Hour+642*10*time.Second/3, diff.Round(time.Millisecond), year)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	assert := assert.New(t)
+	_, err := tekufot.New(5783, hdate.Iyyar, tekufot.Shmuel)
+	assert.Error(err)
+	_, err = tekufot.New(5783, hdate.Nisan, tekufot.Opinion(7))
+	assert.Error(err)
+	_, err = tekufot.New(0, hdate.Nisan, tekufot.Shmuel)
+	assert.Error(err)
+	tk, err := tekufot.New(5783, hdate.Nisan, 0)
+	assert.NoError(err)
+	assert.Equal(tekufot.Shmuel, tk.Opinion)
+}
+
+func TestTalUMatarStart(t *testing.T) {
+	assert := assert.New(t)
+	diaspora := map[int]string{
+		5769: "2008-12-05",
+		5780: "2019-12-06", // the December before a Gregorian leap year
+		5782: "2021-12-05", // Sunday; first said Motzei Shabbat
+		5783: "2022-12-05",
+		5784: "2023-12-06",
+		5785: "2024-12-05",
+	}
+	for year, want := range diaspora {
+		hd := tekufot.TalUMatarStart(year, false)
+		assert.Equal(want, hd.Gregorian().Format("2006-01-02"), year)
+	}
+	assert.Equal(hdate.New(5783, hdate.Cheshvan, 7), tekufot.TalUMatarStart(5783, true))
+}
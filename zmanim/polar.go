@@ -0,0 +1,168 @@
+package zmanim
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// PolarFallback selects how zmanim are approximated on days when the sun
+// does not rise or set, or does not reach the depression below the horizon
+// that a zman requires, as happens at high latitudes around the solstices.
+//
+// A fallback applies to sunrise, sunset and [Zmanim.TimeAtAngle], and so to
+// every zman derived from them.
+type PolarFallback int
+
+const (
+	// PolarNone returns the zero time.Time when the event does not occur.
+	PolarNone PolarFallback = iota
+	// PolarNearestLatitude calculates the event as if the location were at
+	// [Zmanim.PolarLatitude] (60° by default; 45° is far enough from the
+	// pole for dawn and nightfall at any time of year), keeping its
+	// longitude. It returns the zero time if the event does not occur
+	// there either.
+	PolarNearestLatitude
+	// PolarNearestDay uses the nearest date on which the event occurs. For
+	// sunrise and sunset, it takes the time of day of the event on that
+	// date. For a depression angle, it takes the interval between sunrise or
+	// sunset and the event on that date, and applies it to sunrise or sunset
+	// (themselves approximated if need be), so the zmanim of a day stay in
+	// order.
+	PolarNearestDay
+	// PolarFixedMinutes substitutes fixed minutes: a depression angle is
+	// converted to the minutes after sunset (or before sunrise) at which
+	// the sun reaches it in Jerusalem on the equinox (March 16, when a
+	// solar hour is 60 minutes). When the sun does not rise or set, sunrise
+	// and sunset are 6 hours before and after [Zmanim.FixedLocalChatzot].
+	PolarFixedMinutes
+	// PolarJerusalem follows Jerusalem on the same date: a depression angle
+	// is reached as many minutes after sunset (or before sunrise) as in
+	// Jerusalem. When the sun does not rise or set, the event happens at
+	// the local mean time at which it happens in Jerusalem.
+	PolarJerusalem
+)
+
+var polarFallbackNames = []string{"none", "nearest-latitude", "nearest-day", "fixed-minutes", "jerusalem"}
+
+// String returns the name of the fallback, e.g. "nearest-day".
+func (f PolarFallback) String() string {
+	if f < 0 || int(f) >= len(polarFallbackNames) {
+		return fmt.Sprintf("PolarFallback(%d)", int(f))
+	}
+	return polarFallbackNames[f]
+}
+
+// ParsePolarFallback parses a fallback name as returned by
+// PolarFallback.String (case is ignored).
+func ParsePolarFallback(name string) (PolarFallback, error) {
+	name = strings.ToLower(name)
+	for i, s := range polarFallbackNames {
+		if s == name {
+			return PolarFallback(i), nil
+		}
+	}
+	return PolarNone, fmt.Errorf("unknown polar fallback %q", name)
+}
+
+// defaultPolarLatitude is the latitude used by PolarNearestLatitude when
+// Zmanim.PolarLatitude is unset.
+const defaultPolarLatitude = 60
+
+// maxPolarSearchDays bounds the search of PolarNearestDay to half a year
+// in each direction.
+const maxPolarSearchDays = 183
+
+// jerusalem is the reference location of PolarFixedMinutes and
+// PolarJerusalem.
+var jerusalem = NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+
+// polar returns t, or if t is the zero time, the approximation of the event
+// selected by z.PolarFallback. The event is the sun reaching angle degrees
+// below the horizon (0 for sunrise and sunset) in the morning if rising or
+// in the evening otherwise; calc recalculates it for another day or
+// location.
+func (z *Zmanim) polar(t time.Time, angle float64, rising bool, calc func(z *Zmanim) time.Time) time.Time {
+	if !t.IsZero() {
+		return t
+	}
+	switch z.PolarFallback {
+	case PolarNearestLatitude:
+		limit := z.PolarLatitude
+		if limit == 0 {
+			limit = defaultPolarLatitude
+		}
+		if math.Abs(z.Location.Latitude) <= limit {
+			return t
+		}
+		loc := *z.Location
+		loc.Latitude = math.Copysign(limit, loc.Latitude)
+		other := z.withLocation(&loc, z.Year, z.Month, z.Day)
+		return calc(&other)
+	case PolarNearestDay:
+		midnight := time.Date(z.Year, z.Month, z.Day, 0, 0, 0, 0, z.TimeZone)
+		for days := 1; days <= maxPolarSearchDays; days++ {
+			for _, sign := range []int{-1, 1} {
+				date := midnight.AddDate(0, 0, sign*days)
+				other := z.withLocation(z.Location, date.Year(), date.Month(), date.Day())
+				t := calc(&other)
+				if t.IsZero() {
+					continue
+				}
+				if angle == 0 {
+					return midnight.Add(t.Sub(date))
+				}
+				if base := other.TimeAtAngle(0, rising); !base.IsZero() {
+					return z.TimeAtAngle(0, rising).Add(t.Sub(base))
+				}
+			}
+		}
+		return t
+	case PolarFixedMinutes, PolarJerusalem:
+		jer := z.withLocation(&jerusalem, z.Year, z.Month, z.Day)
+		jer.UseElevation = false
+		if angle == 0 {
+			if z.PolarFallback == PolarFixedMinutes {
+				hours := 6 * time.Hour
+				if rising {
+					hours = -hours
+				}
+				return z.FixedLocalChatzot().Add(hours)
+			}
+			lmtOffset := (jerusalem.Longitude - z.Location.Longitude) / 15
+			return calc(&jer).Add(time.Duration(lmtOffset * float64(time.Hour))).In(z.TimeZone)
+		}
+		if z.PolarFallback == PolarFixedMinutes {
+			jer = z.withLocation(&jerusalem, z.Year, time.March, 16)
+		}
+		offset := jer.TimeAtAngle(angle, rising).Sub(jer.TimeAtAngle(0, rising))
+		return z.TimeAtAngle(0, rising).Add(offset)
+	}
+	return t
+}
+
+// withLocation returns a Zmanim for the given location and date with the
+// settings of z, but no polar fallback.
+func (z *Zmanim) withLocation(loc *Location, year int, month time.Month, day int) Zmanim {
+	other := New(loc, time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	other.TimeZone = z.TimeZone
+	other.UseElevation = z.UseElevation
+	return other
+}
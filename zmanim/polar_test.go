@@ -0,0 +1,121 @@
+package zmanim_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolarFallbackNone(t *testing.T) {
+	loc := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	z := zmanim.New(&loc, time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC))
+	assert.True(t, z.Sunset().IsZero())
+	assert.True(t, z.AlotHaShachar().IsZero())
+}
+
+func TestPolarNearestLatitude(t *testing.T) {
+	assert := assert.New(t)
+	tromso := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	lat60 := zmanim.NewLocation("60N", "NO", 60, 18.9551, 0, "Europe/Oslo")
+	lat45 := zmanim.NewLocation("45N", "NO", 45, 18.9551, 0, "Europe/Oslo")
+	dt := time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC)
+	z := zmanim.New(&tromso, dt)
+	z.PolarFallback = zmanim.PolarNearestLatitude
+	z60 := zmanim.New(&lat60, dt)
+	assert.Equal(z60.Sunset(), z.Sunset())
+	assert.Equal(z60.Sunrise(), z.Sunrise())
+	// the sun is never 16.1° below the horizon at 60° in June
+	assert.True(z.AlotHaShachar().IsZero())
+	z.PolarLatitude = 45
+	z45 := zmanim.New(&lat45, dt)
+	assert.Equal(z45.AlotHaShachar(), z.AlotHaShachar())
+	assert.False(z.SofZmanShmaMGA16Point1().IsZero())
+	// no fallback is needed in Jerusalem
+	jer := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	zj := zmanim.New(&jer, dt)
+	zj.PolarFallback = zmanim.PolarNearestLatitude
+	plain := zmanim.New(&jer, dt)
+	assert.Equal(plain.Sunset(), zj.Sunset())
+}
+
+func TestPolarNearestDay(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	z := zmanim.New(&loc, time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC))
+	z.PolarFallback = zmanim.PolarNearestDay
+	sunset := z.Sunset()
+	assert.False(sunset.IsZero())
+	// the midnight sun lasts about a month on either side of the solstice
+	// in Tromso, so the nearest sunset is shortly after midnight
+	assert.Equal(time.June, sunset.Month())
+	assert.Equal(22, sunset.Day())
+	assert.Less(sunset.Hour(), 2)
+	// polar night: the sun does not rise in December
+	z = zmanim.New(&loc, time.Date(2024, time.December, 21, 12, 0, 0, 0, time.UTC))
+	z.PolarFallback = zmanim.PolarNearestDay
+	sunrise := z.Sunrise()
+	assert.Equal(21, sunrise.Day())
+	assert.True(sunrise.Before(z.Sunset()))
+}
+
+func TestPolarNearestDayOrder(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	for dt := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC); dt.Year() == 2024; dt = dt.AddDate(0, 0, 1) {
+		z := zmanim.New(&loc, dt)
+		z.PolarFallback = zmanim.PolarNearestDay
+		sunrise, sunset := z.Sunrise(), z.Sunset()
+		for _, angle := range []float64{zmanim.Tzeit3MediumStars, zmanim.Tzeit3SmallStars, 16.1} {
+			tzeit := z.Tzeit(angle)
+			assert.True(tzeit.After(sunset), "%s %v: tzeit %v before sunset %v",
+				dt.Format("2006-01-02"), angle, tzeit, sunset)
+			alot := z.TimeAtAngle(angle, true)
+			assert.True(alot.Before(sunrise), "%s %v: dawn %v after sunrise %v",
+				dt.Format("2006-01-02"), angle, alot, sunrise)
+		}
+	}
+}
+
+func TestPolarFixedMinutes(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	z := zmanim.New(&loc, time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC))
+	z.PolarFallback = zmanim.PolarFixedMinutes
+	chatzot := z.FixedLocalChatzot()
+	assert.Equal(chatzot.Add(-6*time.Hour), z.Sunrise())
+	assert.Equal(chatzot.Add(6*time.Hour), z.Sunset())
+	// 16.1° is reached about 72 minutes before sunrise in Jerusalem on
+	// the equinox
+	assert.InDelta(72, z.Sunrise().Sub(z.AlotHaShachar()).Minutes(), 2)
+	assert.InDelta(36, z.Tzeit(zmanim.Tzeit3SmallStars).Sub(z.Sunset()).Minutes(), 2)
+}
+
+func TestPolarJerusalem(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	dt := time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC)
+	z := zmanim.New(&loc, dt)
+	z.PolarFallback = zmanim.PolarJerusalem
+	jer := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	zj := zmanim.New(&jer, dt)
+	// same local mean time as Jerusalem
+	lmt := time.Duration((jer.Longitude - loc.Longitude) / 15 * float64(time.Hour))
+	assert.InDelta(0, z.Sunset().Sub(zj.Sunset().Add(lmt)).Seconds(), 1)
+	// same minutes after sunset as Jerusalem
+	tzeit := zj.Tzeit(zmanim.Tzeit3SmallStars).Sub(zj.Sunset())
+	assert.InDelta(0, z.Tzeit(zmanim.Tzeit3SmallStars).Sub(z.Sunset().Add(tzeit)).Seconds(), 1)
+}
+
+func TestParsePolarFallback(t *testing.T) {
+	assert := assert.New(t)
+	for _, f := range []zmanim.PolarFallback{zmanim.PolarNone, zmanim.PolarNearestLatitude,
+		zmanim.PolarNearestDay, zmanim.PolarFixedMinutes, zmanim.PolarJerusalem} {
+		parsed, err := zmanim.ParsePolarFallback(f.String())
+		assert.NoError(err)
+		assert.Equal(f, parsed)
+	}
+	_, err := zmanim.ParsePolarFallback("tundra")
+	assert.EqualError(err, `unknown polar fallback "tundra"`)
+}
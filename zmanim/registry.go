@@ -0,0 +1,315 @@
+package zmanim
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Basis describes how a zman is calculated.
+type Basis int
+
+const (
+	// BasisSunriseSunset zmanim are sunrise, sunset or proportional hours of
+	// the day between them.
+	BasisSunriseSunset Basis = iota
+	// BasisDegrees zmanim depend on the sun's position Value degrees below
+	// the horizon (above it, if Value is negative).
+	BasisDegrees
+	// BasisFixedMinutes zmanim are Value minutes before sunrise or after
+	// sunset, or proportional hours of a day so defined.
+	BasisFixedMinutes
+	// BasisZmaniyotMinutes zmanim are Value proportional minutes (1/720 of
+	// the day from sunrise to sunset) before sunrise or after sunset, or
+	// proportional hours of a day so defined.
+	BasisZmaniyotMinutes
+)
+
+// Zman describes a halachic time that can be calculated by Zmanim.
+type Zman struct {
+	Key     string  // Stable identifier, e.g. "sofZmanShmaMGA16Point1"
+	English string  // English label, e.g. "Kriat Shema, sof zeman (MGA 16.1°)"
+	Hebrew  string  // Hebrew label
+	Opinion string  // e.g. "GRA", "MGA", "Baal HaTanya", or "" for astronomical times
+	Basis   Basis   // How the zman is calculated
+	Value   float64 // Degrees or minutes, according to Basis
+	// Calc returns the zman for a day, or the zero time if it does not
+	// occur. It is usually a method expression such as
+	// (*Zmanim).SofZmanShmaMGA16Point1.
+	Calc func(z *Zmanim) time.Time
+}
+
+var zmanByKey = make(map[string]Zman)
+
+// RegisterZman adds zm to the registry, replacing any zman with the same
+// key (keys are case-insensitive).
+func RegisterZman(zm Zman) {
+	zmanByKey[strings.ToLower(zm.Key)] = zm
+}
+
+// LookupZman returns the registered zman with the given key.
+func LookupZman(key string) (Zman, bool) {
+	zm, ok := zmanByKey[strings.ToLower(key)]
+	return zm, ok
+}
+
+// ZmanKeys returns the keys of all registered zmanim, sorted.
+func ZmanKeys() []string {
+	keys := make([]string, 0, len(zmanByKey))
+	for _, zm := range zmanByKey {
+		keys = append(keys, zm.Key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Profile is a named list of zmanim shown together, reflecting the custom
+// of a community.
+type Profile struct {
+	Name        string   // e.g. "ashkenaz-us"
+	Description string   // Short English description
+	Keys        []string // Keys of the zmanim, in the order shown
+}
+
+// Zmanim returns the registered zmanim of the profile, in order. It returns
+// an error if a key is not registered.
+func (p Profile) Zmanim() ([]Zman, error) {
+	result := make([]Zman, len(p.Keys))
+	for i, key := range p.Keys {
+		zm, ok := LookupZman(key)
+		if !ok {
+			return nil, fmt.Errorf("profile %s: unknown zman %q", p.Name, key)
+		}
+		result[i] = zm
+	}
+	return result, nil
+}
+
+var profileByName = make(map[string]Profile)
+
+// RegisterProfile adds p, replacing any profile with the same name (names
+// are case-insensitive).
+func RegisterProfile(p Profile) {
+	profileByName[strings.ToLower(p.Name)] = p
+}
+
+// LookupProfile returns the registered profile with the given name.
+func LookupProfile(name string) (Profile, bool) {
+	p, ok := profileByName[strings.ToLower(name)]
+	return p, ok
+}
+
+// Profiles returns the names of all registered profiles, sorted.
+func Profiles() []string {
+	names := make([]string, 0, len(profileByName))
+	for _, p := range profileByName {
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultProfile is the name of the profile used by hebcal's daily zmanim
+// when none is chosen.
+const DefaultProfile = "hebcal"
+
+func zman(key, english, hebrew, opinion string, basis Basis, value float64, calc func(z *Zmanim) time.Time) Zman {
+	return Zman{Key: key, English: english, Hebrew: hebrew, Opinion: opinion, Basis: basis, Value: value, Calc: calc}
+}
+
+func init() {
+	for _, zm := range []Zman{
+		// Astronomical
+		zman("dawn", "Dawn", "שחר אזרחי", "", BasisDegrees, 6, (*Zmanim).Dawn),
+		zman("sunrise", "Sunrise", "הנץ החמה", "", BasisSunriseSunset, 0, (*Zmanim).Sunrise),
+		zman("seaLevelSunrise", "Sunrise (sea level)", "הנץ החמה (גובה פני הים)", "", BasisSunriseSunset, 0, (*Zmanim).SeaLevelSunrise),
+		zman("sunset", "Sunset", "שקיעה", "", BasisSunriseSunset, 0, (*Zmanim).Sunset),
+		zman("seaLevelSunset", "Sunset (sea level)", "שקיעה (גובה פני הים)", "", BasisSunriseSunset, 0, (*Zmanim).SeaLevelSunset),
+		zman("visibleSunrise", "Sunrise (visible horizon)", "הנץ החמה (אופק נראה)", "", BasisSunriseSunset, 0, (*Zmanim).VisibleSunrise),
+		zman("visibleSunset", "Sunset (visible horizon)", "שקיעה (אופק נראה)", "", BasisSunriseSunset, 0, (*Zmanim).VisibleSunset),
+		zman("solarNoon", "Solar noon", "צהרי השמש", "", BasisSunriseSunset, 0, (*Zmanim).SolarNoon),
+		zman("dusk", "Dusk", "דמדומים אזרחיים", "", BasisDegrees, 6, (*Zmanim).Dusk),
+
+		// Daily zmanim (Gra)
+		zman("chatzotNight", "Chatzot HaLailah", "חצות הלילה", "GRA", BasisSunriseSunset, 0, (*Zmanim).ChatzotNight),
+		zman("alotHaShachar", "Alot HaShachar", "עלות השחר", "", BasisDegrees, 16.1, (*Zmanim).AlotHaShachar),
+		zman("misheyakir", "Misheyakir", "משיכיר", "", BasisDegrees, 11.5, (*Zmanim).Misheyakir),
+		zman("misheyakirMachmir", "Misheyakir Machmir", "משיכיר מחמיר", "", BasisDegrees, 10.2, (*Zmanim).MisheyakirMachmir),
+		zman("sofZmanShma", "Kriat Shema, sof zeman (GRA)", "סוף זמן קריאת שמע (גר״א)", "GRA", BasisSunriseSunset, 0, (*Zmanim).SofZmanShma),
+		zman("sofZmanTfilla", "Tefilah, sof zeman (GRA)", "סוף זמן תפילה (גר״א)", "GRA", BasisSunriseSunset, 0, (*Zmanim).SofZmanTfilla),
+		zman("sofZmanAchilasChametz", "Finish eating chametz", "סוף זמן אכילת חמץ", "GRA", BasisSunriseSunset, 0, (*Zmanim).SofZmanAchilasChametz),
+		zman("sofZmanBiurChametz", "Biur Chametz", "סוף זמן ביעור חמץ", "GRA", BasisSunriseSunset, 0, (*Zmanim).SofZmanBiurChametz),
+		zman("chatzot", "Chatzot HaYom", "חצות היום", "GRA", BasisSunriseSunset, 0, (*Zmanim).Chatzot),
+		zman("minchaGedola", "Mincha Gedolah", "מנחה גדולה", "GRA", BasisSunriseSunset, 0, (*Zmanim).MinchaGedola),
+		zman("minchaKetana", "Mincha Ketanah", "מנחה קטנה", "GRA", BasisSunriseSunset, 0, (*Zmanim).MinchaKetana),
+		zman("plagHaMincha", "Plag HaMincha", "פלג המנחה", "GRA", BasisSunriseSunset, 0, (*Zmanim).PlagHaMincha),
+
+		// Tzeit
+		zman("beinHashmashos", "Bein HaShemashot", "בין השמשות", "Geonim", BasisDegrees, Tzeit3MediumStars, (*Zmanim).BeinHashmashos),
+		zman("tzeit7083", "Tzeit HaKochavim (7.083°)", "צאת הכוכבים (7.083°)", "Geonim", BasisDegrees, Tzeit3MediumStars,
+			func(z *Zmanim) time.Time { return z.Tzeit(Tzeit3MediumStars) }),
+		zman("tzeit85", "Tzeit HaKochavim", "צאת הכוכבים", "Geonim", BasisDegrees, Tzeit3SmallStars,
+			func(z *Zmanim) time.Time { return z.Tzeit(Tzeit3SmallStars) }),
+		zman("tzeit42min", "Tzeit HaKochavim (42 min)", "צאת הכוכבים (42 דקות)", "", BasisFixedMinutes, 42,
+			func(z *Zmanim) time.Time { return z.SunsetOffset(42, false) }),
+		zman("tzeit50min", "Tzeit HaKochavim (50 min)", "צאת הכוכבים (50 דקות)", "", BasisFixedMinutes, 50,
+			func(z *Zmanim) time.Time { return z.SunsetOffset(50, false) }),
+		zman("tzaisGeonim3Point7Degrees", "Tzeit HaKochavim (Geonim 3.7°)", "צאת הכוכבים (גאונים 3.7°)", "Geonim", BasisDegrees, 3.7, (*Zmanim).TzaisGeonim3Point7Degrees),
+		zman("tzaisGeonim3Point8Degrees", "Tzeit HaKochavim (Geonim 3.8°)", "צאת הכוכבים (גאונים 3.8°)", "Geonim", BasisDegrees, 3.8, (*Zmanim).TzaisGeonim3Point8Degrees),
+		zman("tzaisGeonim5Point95Degrees", "Tzeit HaKochavim (Geonim 5.95°)", "צאת הכוכבים (גאונים 5.95°)", "Geonim", BasisDegrees, 5.95, (*Zmanim).TzaisGeonim5Point95Degrees),
+		zman("tzaisGeonim6Point45Degrees", "Tzeit HaKochavim (Geonim 6.45°)", "צאת הכוכבים (גאונים 6.45°)", "Geonim", BasisDegrees, 6.45, (*Zmanim).TzaisGeonim6Point45Degrees),
+		zman("tzais72", "Tzeit HaKochavim (Rabbeinu Tam)", "צאת הכוכבים (רבנו תם)", "Rabbeinu Tam", BasisFixedMinutes, 72, (*Zmanim).Tzais72),
+		zman("tzais72Zmanis", "Tzeit HaKochavim (Rabbeinu Tam, 72 min zmaniyot)", "צאת הכוכבים (רבנו תם, 72 דקות זמניות)", "Rabbeinu Tam", BasisZmaniyotMinutes, 72, (*Zmanim).Tzais72Zmanis),
+		zman("tzais90", "Tzeit HaKochavim (90 min)", "צאת הכוכבים (90 דקות)", "MGA", BasisFixedMinutes, 90, (*Zmanim).Tzais90),
+		zman("tzais90Zmanis", "Tzeit HaKochavim (90 min zmaniyot)", "צאת הכוכבים (90 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 90, (*Zmanim).Tzais90Zmanis),
+		zman("tzais96", "Tzeit HaKochavim (96 min)", "צאת הכוכבים (96 דקות)", "MGA", BasisFixedMinutes, 96, (*Zmanim).Tzais96),
+		zman("tzais96Zmanis", "Tzeit HaKochavim (96 min zmaniyot)", "צאת הכוכבים (96 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 96, (*Zmanim).Tzais96Zmanis),
+		zman("tzais120", "Tzeit HaKochavim (120 min)", "צאת הכוכבים (120 דקות)", "MGA", BasisFixedMinutes, 120, (*Zmanim).Tzais120),
+		zman("tzais120Zmanis", "Tzeit HaKochavim (120 min zmaniyot)", "צאת הכוכבים (120 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 120, (*Zmanim).Tzais120Zmanis),
+
+		// MGA
+		zman("alos72", "Alot HaShachar (72 min)", "עלות השחר (72 דקות)", "MGA", BasisFixedMinutes, 72, (*Zmanim).Alos72),
+		zman("alos72Zmanis", "Alot HaShachar (72 min zmaniyot)", "עלות השחר (72 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 72, (*Zmanim).Alos72Zmanis),
+		zman("alos90", "Alot HaShachar (90 min)", "עלות השחר (90 דקות)", "MGA", BasisFixedMinutes, 90, (*Zmanim).Alos90),
+		zman("alos90Zmanis", "Alot HaShachar (90 min zmaniyot)", "עלות השחר (90 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 90, (*Zmanim).Alos90Zmanis),
+		zman("alos96", "Alot HaShachar (96 min)", "עלות השחר (96 דקות)", "MGA", BasisFixedMinutes, 96, (*Zmanim).Alos96),
+		zman("alos96Zmanis", "Alot HaShachar (96 min zmaniyot)", "עלות השחר (96 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 96, (*Zmanim).Alos96Zmanis),
+		zman("alos120", "Alot HaShachar (120 min)", "עלות השחר (120 דקות)", "MGA", BasisFixedMinutes, 120, (*Zmanim).Alos120),
+		zman("alos120Zmanis", "Alot HaShachar (120 min zmaniyot)", "עלות השחר (120 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 120, (*Zmanim).Alos120Zmanis),
+		zman("sofZmanShmaMGA", "Kriat Shema, sof zeman (MGA)", "סוף זמן קריאת שמע (מג״א)", "MGA", BasisFixedMinutes, 72, (*Zmanim).SofZmanShmaMGA),
+		zman("sofZmanShmaMGA16Point1", "Kriat Shema, sof zeman (MGA 16.1°)", "סוף זמן קריאת שמע (מג״א 16.1°)", "MGA", BasisDegrees, 16.1, (*Zmanim).SofZmanShmaMGA16Point1),
+		zman("sofZmanShmaMGA19Point8", "Kriat Shema, sof zeman (MGA 19.8°)", "סוף זמן קריאת שמע (מג״א 19.8°)", "MGA", BasisDegrees, 19.8, (*Zmanim).SofZmanShmaMGA19Point8),
+		zman("sofZmanShmaMGA72MinutesZmanis", "Kriat Shema, sof zeman (MGA 72 min zmaniyot)", "סוף זמן קריאת שמע (מג״א 72 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 72, (*Zmanim).SofZmanShmaMGA72MinutesZmanis),
+		zman("sofZmanShmaMGA90Minutes", "Kriat Shema, sof zeman (MGA 90 min)", "סוף זמן קריאת שמע (מג״א 90 דקות)", "MGA", BasisFixedMinutes, 90, (*Zmanim).SofZmanShmaMGA90Minutes),
+		zman("sofZmanShmaMGA90MinutesZmanis", "Kriat Shema, sof zeman (MGA 90 min zmaniyot)", "סוף זמן קריאת שמע (מג״א 90 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 90, (*Zmanim).SofZmanShmaMGA90MinutesZmanis),
+		zman("sofZmanShmaMGA96Minutes", "Kriat Shema, sof zeman (MGA 96 min)", "סוף זמן קריאת שמע (מג״א 96 דקות)", "MGA", BasisFixedMinutes, 96, (*Zmanim).SofZmanShmaMGA96Minutes),
+		zman("sofZmanShmaMGA96MinutesZmanis", "Kriat Shema, sof zeman (MGA 96 min zmaniyot)", "סוף זמן קריאת שמע (מג״א 96 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 96, (*Zmanim).SofZmanShmaMGA96MinutesZmanis),
+		zman("sofZmanShmaMGA120Minutes", "Kriat Shema, sof zeman (MGA 120 min)", "סוף זמן קריאת שמע (מג״א 120 דקות)", "MGA", BasisFixedMinutes, 120, (*Zmanim).SofZmanShmaMGA120Minutes),
+		zman("sofZmanTfillaMGA", "Tefilah, sof zeman (MGA)", "סוף זמן תפילה (מג״א)", "MGA", BasisFixedMinutes, 72, (*Zmanim).SofZmanTfillaMGA),
+		zman("sofZmanTfillaMGA16Point1", "Tefilah, sof zeman (MGA 16.1°)", "סוף זמן תפילה (מג״א 16.1°)", "MGA", BasisDegrees, 16.1, (*Zmanim).SofZmanTfillaMGA16Point1),
+		zman("sofZmanTfillaMGA19Point8", "Tefilah, sof zeman (MGA 19.8°)", "סוף זמן תפילה (מג״א 19.8°)", "MGA", BasisDegrees, 19.8, (*Zmanim).SofZmanTfillaMGA19Point8),
+		zman("sofZmanTfillaMGA72MinutesZmanis", "Tefilah, sof zeman (MGA 72 min zmaniyot)", "סוף זמן תפילה (מג״א 72 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 72, (*Zmanim).SofZmanTfillaMGA72MinutesZmanis),
+		zman("sofZmanTfillaMGA90Minutes", "Tefilah, sof zeman (MGA 90 min)", "סוף זמן תפילה (מג״א 90 דקות)", "MGA", BasisFixedMinutes, 90, (*Zmanim).SofZmanTfillaMGA90Minutes),
+		zman("sofZmanTfillaMGA90MinutesZmanis", "Tefilah, sof zeman (MGA 90 min zmaniyot)", "סוף זמן תפילה (מג״א 90 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 90, (*Zmanim).SofZmanTfillaMGA90MinutesZmanis),
+		zman("sofZmanTfillaMGA96Minutes", "Tefilah, sof zeman (MGA 96 min)", "סוף זמן תפילה (מג״א 96 דקות)", "MGA", BasisFixedMinutes, 96, (*Zmanim).SofZmanTfillaMGA96Minutes),
+		zman("sofZmanTfillaMGA96MinutesZmanis", "Tefilah, sof zeman (MGA 96 min zmaniyot)", "סוף זמן תפילה (מג״א 96 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 96, (*Zmanim).SofZmanTfillaMGA96MinutesZmanis),
+		zman("sofZmanTfillaMGA120Minutes", "Tefilah, sof zeman (MGA 120 min)", "סוף זמן תפילה (מג״א 120 דקות)", "MGA", BasisFixedMinutes, 120, (*Zmanim).SofZmanTfillaMGA120Minutes),
+		zman("minchaGedolaMGA", "Mincha Gedolah (MGA)", "מנחה גדולה (מג״א)", "MGA", BasisFixedMinutes, 72, (*Zmanim).MinchaGedolaMGA),
+		zman("minchaKetanaMGA", "Mincha Ketanah (MGA)", "מנחה קטנה (מג״א)", "MGA", BasisFixedMinutes, 72, (*Zmanim).MinchaKetanaMGA),
+		zman("plagHaMincha72Minutes", "Plag HaMincha (MGA 72 min)", "פלג המנחה (מג״א 72 דקות)", "MGA", BasisFixedMinutes, 72, (*Zmanim).PlagHaMincha72Minutes),
+		zman("plagHaMincha72MinutesZmanis", "Plag HaMincha (MGA 72 min zmaniyot)", "פלג המנחה (מג״א 72 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 72, (*Zmanim).PlagHaMincha72MinutesZmanis),
+		zman("plagHaMincha90Minutes", "Plag HaMincha (MGA 90 min)", "פלג המנחה (מג״א 90 דקות)", "MGA", BasisFixedMinutes, 90, (*Zmanim).PlagHaMincha90Minutes),
+		zman("plagHaMincha90MinutesZmanis", "Plag HaMincha (MGA 90 min zmaniyot)", "פלג המנחה (מג״א 90 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 90, (*Zmanim).PlagHaMincha90MinutesZmanis),
+		zman("plagHaMincha96Minutes", "Plag HaMincha (MGA 96 min)", "פלג המנחה (מג״א 96 דקות)", "MGA", BasisFixedMinutes, 96, (*Zmanim).PlagHaMincha96Minutes),
+		zman("plagHaMincha96MinutesZmanis", "Plag HaMincha (MGA 96 min zmaniyot)", "פלג המנחה (מג״א 96 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 96, (*Zmanim).PlagHaMincha96MinutesZmanis),
+		zman("plagHaMincha120Minutes", "Plag HaMincha (MGA 120 min)", "פלג המנחה (מג״א 120 דקות)", "MGA", BasisFixedMinutes, 120, (*Zmanim).PlagHaMincha120Minutes),
+		zman("plagHaMincha120MinutesZmanis", "Plag HaMincha (MGA 120 min zmaniyot)", "פלג המנחה (מג״א 120 דקות זמניות)", "MGA", BasisZmaniyotMinutes, 120, (*Zmanim).PlagHaMincha120MinutesZmanis),
+
+		// Baal HaTanya
+		zman("alosBaalHatanya", "Alot HaShachar (Baal HaTanya)", "עלות השחר (בעל התניא)", "Baal HaTanya", BasisDegrees, 16.9, (*Zmanim).AlosBaalHatanya),
+		zman("sofZmanShmaBaalHatanya", "Kriat Shema, sof zeman (Baal HaTanya)", "סוף זמן קריאת שמע (בעל התניא)", "Baal HaTanya", BasisDegrees, zenithBaalHatanya, (*Zmanim).SofZmanShmaBaalHatanya),
+		zman("sofZmanTfilaBaalHatanya", "Tefilah, sof zeman (Baal HaTanya)", "סוף זמן תפילה (בעל התניא)", "Baal HaTanya", BasisDegrees, zenithBaalHatanya, (*Zmanim).SofZmanTfilaBaalHatanya),
+		zman("minchaGedolaBaalHatanya", "Mincha Gedolah (Baal HaTanya)", "מנחה גדולה (בעל התניא)", "Baal HaTanya", BasisDegrees, zenithBaalHatanya, (*Zmanim).MinchaGedolaBaalHatanya),
+		zman("minchaKetanaBaalHatanya", "Mincha Ketanah (Baal HaTanya)", "מנחה קטנה (בעל התניא)", "Baal HaTanya", BasisDegrees, zenithBaalHatanya, (*Zmanim).MinchaKetanaBaalHatanya),
+		zman("plagHaminchaBaalHatanya", "Plag HaMincha (Baal HaTanya)", "פלג המנחה (בעל התניא)", "Baal HaTanya", BasisDegrees, zenithBaalHatanya, (*Zmanim).PlagHaminchaBaalHatanya),
+		zman("tzaisBaalHatanya", "Tzeit HaKochavim (Baal HaTanya)", "צאת הכוכבים (בעל התניא)", "Baal HaTanya", BasisDegrees, 6, (*Zmanim).TzaisBaalHatanya),
+
+		// Ateret Torah
+		zman("tzaisAteretTorah", "Tzeit HaKochavim (Ateret Torah)", "צאת הכוכבים (עטרת תורה)", "Ateret Torah", BasisFixedMinutes, 40, (*Zmanim).TzaisAteretTorah),
+		zman("sofZmanShmaAteretTorah", "Kriat Shema, sof zeman (Ateret Torah)", "סוף זמן קריאת שמע (עטרת תורה)", "Ateret Torah", BasisZmaniyotMinutes, 72, (*Zmanim).SofZmanShmaAteretTorah),
+		zman("sofZmanTfillaAteretTorah", "Tefilah, sof zeman (Ateret Torah)", "סוף זמן תפילה (עטרת תורה)", "Ateret Torah", BasisZmaniyotMinutes, 72, (*Zmanim).SofZmanTfillaAteretTorah),
+		zman("minchaGedolaAteretTorah", "Mincha Gedolah (Ateret Torah)", "מנחה גדולה (עטרת תורה)", "Ateret Torah", BasisZmaniyotMinutes, 72, (*Zmanim).MinchaGedolaAteretTorah),
+		zman("minchaKetanaAteretTorah", "Mincha Ketanah (Ateret Torah)", "מנחה קטנה (עטרת תורה)", "Ateret Torah", BasisZmaniyotMinutes, 72, (*Zmanim).MinchaKetanaAteretTorah),
+		zman("plagHaMinchaAteretTorah", "Plag HaMincha (Ateret Torah)", "פלג המנחה (עטרת תורה)", "Ateret Torah", BasisZmaniyotMinutes, 72, (*Zmanim).PlagHaMinchaAteretTorah),
+
+		// Yereim
+		zman("beinHashmashosYereim18Minutes", "Bein HaShemashot (Yereim 18 min)", "בין השמשות (יראים 18 דקות)", "Yereim", BasisFixedMinutes, 18, (*Zmanim).BeinHashmashosYereim18Minutes),
+		zman("beinHashmashosYereim16Point875Minutes", "Bein HaShemashot (Yereim 16.875 min)", "בין השמשות (יראים 16.875 דקות)", "Yereim", BasisFixedMinutes, 16.875, (*Zmanim).BeinHashmashosYereim16Point875Minutes),
+		zman("beinHashmashosYereim13Point5Minutes", "Bein HaShemashot (Yereim 13.5 min)", "בין השמשות (יראים 13.5 דקות)", "Yereim", BasisFixedMinutes, 13.5, (*Zmanim).BeinHashmashosYereim13Point5Minutes),
+		zman("beinHashmashosYereim3Point05Degrees", "Bein HaShemashot (Yereim 3.05°)", "בין השמשות (יראים 3.05°)", "Yereim", BasisDegrees, -3.05, (*Zmanim).BeinHashmashosYereim3Point05Degrees),
+		zman("beinHashmashosYereim2Point8Degrees", "Bein HaShemashot (Yereim 2.8°)", "בין השמשות (יראים 2.8°)", "Yereim", BasisDegrees, -2.8, (*Zmanim).BeinHashmashosYereim2Point8Degrees),
+		zman("beinHashmashosYereim2Point1Degrees", "Bein HaShemashot (Yereim 2.1°)", "בין השמשות (יראים 2.1°)", "Yereim", BasisDegrees, -2.1, (*Zmanim).BeinHashmashosYereim2Point1Degrees),
+
+		// Fixed local chatzot
+		zman("fixedLocalChatzot", "Chatzot HaYom (fixed local)", "חצות היום (זמן מקומי)", "", BasisSunriseSunset, 0, (*Zmanim).FixedLocalChatzot),
+		zman("sofZmanShmaGRASunriseToFixedLocalChatzot", "Kriat Shema, sof zeman (GRA, fixed chatzot)", "סוף זמן קריאת שמע (גר״א, חצות קבועה)", "GRA", BasisSunriseSunset, 0, (*Zmanim).SofZmanShmaGRASunriseToFixedLocalChatzot),
+		zman("sofZmanTfillaGRASunriseToFixedLocalChatzot", "Tefilah, sof zeman (GRA, fixed chatzot)", "סוף זמן תפילה (גר״א, חצות קבועה)", "GRA", BasisSunriseSunset, 0, (*Zmanim).SofZmanTfillaGRASunriseToFixedLocalChatzot),
+		zman("minchaGedolaGRAFixedLocalChatzot30Minutes", "Mincha Gedolah (fixed chatzot + 30 min)", "מנחה גדולה (חצות קבועה + 30 דקות)", "GRA", BasisFixedMinutes, 30, (*Zmanim).MinchaGedolaGRAFixedLocalChatzot30Minutes),
+		zman("minchaKetanaGRAFixedLocalChatzotToSunset", "Mincha Ketanah (GRA, fixed chatzot)", "מנחה קטנה (גר״א, חצות קבועה)", "GRA", BasisSunriseSunset, 0, (*Zmanim).MinchaKetanaGRAFixedLocalChatzotToSunset),
+		zman("plagHaMinchaGRAFixedLocalChatzotToSunset", "Plag HaMincha (GRA, fixed chatzot)", "פלג המנחה (גר״א, חצות קבועה)", "GRA", BasisSunriseSunset, 0, (*Zmanim).PlagHaMinchaGRAFixedLocalChatzotToSunset),
+	} {
+		RegisterZman(zm)
+	}
+
+	for _, p := range []Profile{
+		{
+			Name:        DefaultProfile,
+			Description: "Hebcal's classic daily zmanim",
+			Keys: []string{
+				"alotHaShachar", "misheyakir", "misheyakirMachmir", "sunrise",
+				"sofZmanShmaMGA", "sofZmanShma", "sofZmanTfillaMGA", "sofZmanTfilla",
+				"chatzot", "minchaGedola", "minchaKetana", "plagHaMincha",
+				"sunset", "beinHashmashos", "tzeit85",
+			},
+		},
+		{
+			Name:        "ashkenaz-us",
+			Description: "Ashkenazi synagogues in North America",
+			Keys: []string{
+				"alotHaShachar", "misheyakir", "sunrise",
+				"sofZmanShmaMGA", "sofZmanShma", "sofZmanTfilla",
+				"chatzot", "minchaGedola", "minchaKetana", "plagHaMincha",
+				"sunset", "tzeit85", "tzeit50min",
+			},
+		},
+		{
+			Name:        "chabad",
+			Description: "Chabad, according to the Baal HaTanya",
+			Keys: []string{
+				"alosBaalHatanya", "misheyakir", "sunrise",
+				"sofZmanShmaBaalHatanya", "sofZmanTfilaBaalHatanya",
+				"chatzot", "minchaGedolaBaalHatanya", "minchaKetanaBaalHatanya",
+				"plagHaminchaBaalHatanya", "sunset", "tzaisBaalHatanya",
+			},
+		},
+		{
+			Name:        "sephardi-il",
+			Description: "Sephardi communities in Israel, using proportional minutes",
+			Keys: []string{
+				"alos72Zmanis", "misheyakir", "sunrise",
+				"sofZmanShmaMGA72MinutesZmanis", "sofZmanShma", "sofZmanTfilla",
+				"chatzot", "minchaGedola", "minchaKetana", "plagHaMincha",
+				"sunset", "tzaisGeonim3Point7Degrees", "tzais72Zmanis",
+			},
+		},
+		{
+			Name:        "yeshivish",
+			Description: "Yeshivot following the MGA stringencies and Rabbeinu Tam",
+			Keys: []string{
+				"alos72", "alotHaShachar", "misheyakirMachmir", "sunrise",
+				"sofZmanShmaMGA", "sofZmanShma", "sofZmanTfillaMGA", "sofZmanTfilla",
+				"chatzot", "minchaGedola", "minchaKetana", "plagHaMincha",
+				"sunset", "tzeit85", "tzais72",
+			},
+		},
+	} {
+		RegisterProfile(p)
+	}
+}
@@ -0,0 +1,60 @@
+package zmanim_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupZman(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	zm, ok := zmanim.LookupZman("sofZmanShmaMGA16Point1")
+	assert.True(ok)
+	assert.Equal("MGA", zm.Opinion)
+	assert.Equal(zmanim.BasisDegrees, zm.Basis)
+	assert.Equal(16.1, zm.Value)
+	assert.Equal(z.SofZmanShmaMGA16Point1(), zm.Calc(&z))
+	zm, ok = zmanim.LookupZman("TZEIT7083")
+	assert.True(ok)
+	assert.Equal("tzeit7083", zm.Key)
+	assert.Equal(z.Tzeit(zmanim.Tzeit3MediumStars), zm.Calc(&z))
+	_, ok = zmanim.LookupZman("noSuchZman")
+	assert.False(ok)
+}
+
+func TestZmanKeys(t *testing.T) {
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	keys := zmanim.ZmanKeys()
+	assert.Contains(t, keys, "tzais72")
+	for _, key := range keys {
+		zm, ok := zmanim.LookupZman(key)
+		assert.True(t, ok, key)
+		assert.NotEmpty(t, zm.English, key)
+		assert.NotEmpty(t, zm.Hebrew, key)
+		assert.False(t, zm.Calc(&z).IsZero(), key)
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"ashkenaz-us", "chabad", "hebcal", "sephardi-il", "yeshivish"}, zmanim.Profiles())
+	for _, name := range zmanim.Profiles() {
+		p, ok := zmanim.LookupProfile(name)
+		assert.True(ok, name)
+		zmans, err := p.Zmanim()
+		assert.NoError(err, name)
+		assert.Equal(len(p.Keys), len(zmans), name)
+	}
+	p, _ := zmanim.LookupProfile("Chabad")
+	zmans, _ := p.Zmanim()
+	assert.Equal("alosBaalHatanya", zmans[0].Key)
+	_, ok := zmanim.LookupProfile("nusach-mars")
+	assert.False(ok)
+	_, err := zmanim.Profile{Name: "bad", Keys: []string{"sunrise", "noSuchZman"}}.Zmanim()
+	assert.EqualError(err, `profile bad: unknown zman "noSuchZman"`)
+}
@@ -0,0 +1,129 @@
+package zmanim
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"math"
+	"time"
+
+	noaa "github.com/hebcal/noaa-go"
+)
+
+// SolarElevation returns the altitude of the center of the sun above the
+// horizon, in degrees, at the instant t as seen from the location. It is
+// corrected for atmospheric refraction and is negative when the sun is
+// below the horizon.
+func (z *Zmanim) SolarElevation(t time.Time) float64 {
+	return z.calc.GetSolarElevation(t, z.geo)
+}
+
+// SolarAzimuth returns the compass bearing of the sun, in degrees clockwise
+// from true north (so 90° is due east and 180° due south), at the instant t
+// as seen from the location.
+func (z *Zmanim) SolarAzimuth(t time.Time) float64 {
+	return z.calc.GetSolarAzimuth(t, z.geo)
+}
+
+// SunDeclination returns the declination of the sun, in degrees north of the
+// celestial equator, at the instant t. It ranges from about -23.44° at the
+// December solstice to +23.44° at the June solstice.
+func (z *Zmanim) SunDeclination(t time.Time) float64 {
+	utc := t.UTC()
+	midnight := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+	jd := noaa.JulianDay(utc) + utc.Sub(midnight).Hours()/24
+	return z.calc.GetSunDeclination(noaa.JulianCenturiesFromJulianDay(jd))
+}
+
+// SolarNoon returns astronomical noon, when the sun crosses the meridian
+// and is at its highest point of the day. Unlike [Zmanim.Chatzot], it does
+// not depend on sunrise and sunset, so it exists even when the sun does not
+// rise or set.
+func (z *Zmanim) SolarNoon() time.Time {
+	t := z.calc.GetUTCNoon(z.adjustedDate(), z.geo)
+	return z.inLoc(z.getInstantFromTime(t, eventNoon))
+}
+
+// SolarMidnight returns astronomical midnight at the end of the day, about
+// 12 hours after [Zmanim.SolarNoon], when the sun is at its lowest point.
+func (z *Zmanim) SolarMidnight() time.Time {
+	t := z.calc.GetUTCMidnight(z.adjustedDate(), z.geo)
+	return z.inLoc(z.getInstantFromTime(t, eventMidnight))
+}
+
+// TimeAtAltitude returns when the center of the sun will be some angle
+// above the horizon, the counterpart of [Zmanim.TimeAtAngle] for angles
+// above the horizon. The rising parameter chooses between the AM or PM
+// time. The angle is geometric: no allowance is made for refraction or for
+// the radius of the sun.
+//
+// Returns time.Time{} if the sun does not reach the altitude.
+func (z *Zmanim) TimeAtAltitude(altitude float64, rising bool) time.Time {
+	zenith := noaa.GeometricZenith - altitude
+	if zenith == noaa.GeometricZenith {
+		// the calculator treats exactly 90° as sunrise or sunset and adds
+		// refraction and the solar radius
+		zenith = math.Nextafter(zenith, 0)
+	}
+	var t time.Time
+	if rising {
+		utc := z.calc.GetUTCSunrise(z.adjustedDate(), z.geo, zenith, false)
+		t = z.inLoc(z.getInstantFromTime(utc, eventSunrise))
+	} else {
+		utc := z.calc.GetUTCSunset(z.adjustedDate(), z.geo, zenith, false)
+		t = z.inLoc(z.getInstantFromTime(utc, eventSunset))
+	}
+	return z.polar(t, -altitude, rising, func(other *Zmanim) time.Time {
+		return other.TimeAtAltitude(altitude, rising)
+	})
+}
+
+// VisibleSunrise ("netz hachama hanireh") is when the upper edge of the sun
+// appears over the visible horizon to the east, whose altitude is given by
+// [Zmanim.EastHorizon]. With a horizon at 0° it matches SeaLevelSunrise.
+//
+// Returns time.Time{} if the sun does not clear the horizon.
+func (z *Zmanim) VisibleSunrise() time.Time {
+	return z.visibleRiseSet(z.EastHorizon, true)
+}
+
+// VisibleSunset is when the upper edge of the sun disappears behind the
+// visible horizon to the west, whose altitude is given by
+// [Zmanim.WestHorizon]. With a horizon at 0° it matches SeaLevelSunset.
+//
+// Returns time.Time{} if the sun does not clear the horizon.
+func (z *Zmanim) VisibleSunset() time.Time {
+	return z.visibleRiseSet(z.WestHorizon, false)
+}
+
+// visibleRiseSet returns when the upper edge of the sun crosses a horizon
+// at the given apparent altitude. The center of the sun is then lower by the
+// apparent solar radius and by the refraction at that altitude.
+func (z *Zmanim) visibleRiseSet(horizon float64, rising bool) time.Time {
+	date := time.Date(z.Year, z.Month, z.Day, 0, 0, 0, 0, time.UTC)
+	altitude := horizon - z.calc.GetApparentSolarRadius(date) - z.refraction(horizon)
+	return z.TimeAtAltitude(altitude, rising)
+}
+
+// refraction returns the atmospheric refraction, in degrees, of an object
+// at the given apparent altitude, using Bennett's formula scaled to agree
+// with the calculator's refraction at the horizon.
+func (z *Zmanim) refraction(altitude float64) float64 {
+	bennett := func(h float64) float64 {
+		return 1 / math.Tan((h+7.31/(h+4.4))*math.Pi/180)
+	}
+	return z.calc.GetRefraction() * bennett(altitude) / bennett(0)
+}
@@ -0,0 +1,80 @@
+package zmanim_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolarPosition(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	noon := z.SolarNoon()
+	assert.Equal("2024-04-26T12:36:52+03:00", noon.Format(time.RFC3339))
+	assert.InDelta(0, noon.Sub(z.Chatzot()).Seconds(), 60)
+	decl := z.SunDeclination(noon)
+	assert.InDelta(13.73, decl, 0.01)
+	assert.InDelta(90-loc.Latitude+decl, z.SolarElevation(noon), 0.05)
+	assert.InDelta(180, z.SolarAzimuth(noon), 0.5)
+	assert.InDelta(90, z.SolarAzimuth(z.TimeAtAltitude(30, true)), 30)
+	assert.InDelta(270, z.SolarAzimuth(z.TimeAtAltitude(30, false)), 30)
+	midnight := z.SolarMidnight()
+	assert.InDelta(12, midnight.Sub(noon).Hours(), 0.01)
+	assert.InDelta(-(90 - loc.Latitude - decl), z.SolarElevation(midnight), 0.5)
+
+	june := time.Date(2024, time.June, 20, 20, 51, 0, 0, time.UTC)
+	december := time.Date(2024, time.December, 21, 9, 20, 0, 0, time.UTC)
+	assert.InDelta(23.44, z.SunDeclination(june), 0.01)
+	assert.InDelta(-23.44, z.SunDeclination(december), 0.01)
+}
+
+func TestTimeAtAltitude(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	for _, altitude := range []float64{0, 5, 10, 45} {
+		for _, rising := range []bool{true, false} {
+			tm := z.TimeAtAltitude(altitude, rising)
+			// the elevation includes about 0.1° of refraction at 10°
+			assert.InDelta(altitude, z.SolarElevation(tm), 0.6, "%v %v", altitude, rising)
+		}
+	}
+	assert.Equal(z.TimeAtAngle(5, true), z.TimeAtAltitude(-5, true))
+	assert.Equal(z.TimeAtAngle(5, false), z.TimeAtAltitude(-5, false))
+	// the sun does not climb to 80° in Jerusalem
+	assert.True(z.TimeAtAltitude(80, true).IsZero())
+}
+
+func TestVisibleSunrise(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	assert.InDelta(0, z.VisibleSunrise().Sub(z.SeaLevelSunrise()).Seconds(), 1)
+	assert.InDelta(0, z.VisibleSunset().Sub(z.SeaLevelSunset()).Seconds(), 1)
+
+	// mountains to the east delay netz; a lower western horizon delays shkiah
+	z.EastHorizon = 1.5
+	z.WestHorizon = -0.5
+	netz := z.VisibleSunrise()
+	assert.Equal("2024-04-26T06:06:40+03:00", netz.Format(time.RFC3339))
+	assert.InDelta(8, netz.Sub(z.SeaLevelSunrise()).Minutes(), 1)
+	assert.True(z.VisibleSunset().After(z.SeaLevelSunset()))
+	zm, ok := zmanim.LookupZman("visibleSunrise")
+	assert.True(ok)
+	assert.Equal(netz, zm.Calc(&z))
+}
+
+func TestSolarPolar(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	z := zmanim.New(&loc, time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC))
+	assert.False(z.SolarNoon().IsZero())
+	assert.Greater(z.SolarElevation(z.SolarMidnight()), 0.0)
+	assert.True(z.TimeAtAltitude(0, true).IsZero())
+	assert.True(z.VisibleSunrise().IsZero())
+	z.PolarFallback = zmanim.PolarNearestLatitude
+	assert.False(z.VisibleSunrise().IsZero())
+}
@@ -61,6 +61,21 @@ type Zmanim struct {
 	// are intentionally never affected by elevation. Defaults to false.
 	UseElevation bool
 
+	// PolarFallback selects how sunrise, sunset and the zmanim derived from
+	// them are approximated when the sun does not rise, set or reach the
+	// required depression. Defaults to PolarNone, which returns the zero
+	// time.Time.
+	PolarFallback PolarFallback
+	// PolarLatitude is the latitude used by PolarNearestLatitude (default 60).
+	PolarLatitude float64
+
+	// EastHorizon and WestHorizon are the altitudes, in degrees, of the
+	// visible horizon to the east and west of the location, as when
+	// mountains or buildings hide the sea-level horizon. They are used only
+	// by [Zmanim.VisibleSunrise] and [Zmanim.VisibleSunset]. Default to 0.
+	EastHorizon float64
+	WestHorizon float64
+
 	geo  *noaa.GeoLocation
 	calc *noaa.NOAACalculator
 }
@@ -154,7 +169,7 @@ func (z *Zmanim) getInstantFromTime(timeHours float64, event solarEventType) tim
 // Returns time.Time{} if the sun does not rise or set
 func (z *Zmanim) Sunset() time.Time {
 	t := z.calc.GetUTCSunset(z.adjustedDate(), z.geo, noaa.GeometricZenith, z.UseElevation)
-	return z.inLoc(z.getInstantFromTime(t, eventSunset))
+	return z.polar(z.inLoc(z.getInstantFromTime(t, eventSunset)), 0, false, (*Zmanim).Sunset)
 }
 
 // Sunrise ("neitz haChama") is defined as when the upper edge of the
@@ -163,7 +178,7 @@ func (z *Zmanim) Sunset() time.Time {
 // included in the calculation.
 func (z *Zmanim) Sunrise() time.Time {
 	t := z.calc.GetUTCSunrise(z.adjustedDate(), z.geo, noaa.GeometricZenith, z.UseElevation)
-	return z.inLoc(z.getInstantFromTime(t, eventSunrise))
+	return z.polar(z.inLoc(z.getInstantFromTime(t, eventSunrise)), 0, true, (*Zmanim).Sunrise)
 }
 
 // TimeAtAngle returns when the center of the sun will be some angle
@@ -175,12 +190,17 @@ func (z *Zmanim) Sunrise() time.Time {
 // result is not affected by elevation.
 func (z *Zmanim) TimeAtAngle(angle float64, rising bool) time.Time {
 	zenith := noaa.GeometricZenith + angle
+	var t time.Time
 	if rising {
-		t := z.calc.GetUTCSunrise(z.adjustedDate(), z.geo, zenith, false)
-		return z.inLoc(z.getInstantFromTime(t, eventSunrise))
+		utc := z.calc.GetUTCSunrise(z.adjustedDate(), z.geo, zenith, false)
+		t = z.inLoc(z.getInstantFromTime(utc, eventSunrise))
+	} else {
+		utc := z.calc.GetUTCSunset(z.adjustedDate(), z.geo, zenith, false)
+		t = z.inLoc(z.getInstantFromTime(utc, eventSunset))
 	}
-	t := z.calc.GetUTCSunset(z.adjustedDate(), z.geo, zenith, false)
-	return z.inLoc(z.getInstantFromTime(t, eventSunset))
+	return z.polar(t, angle, rising, func(other *Zmanim) time.Time {
+		return other.TimeAtAngle(angle, rising)
+	})
 }
 
 // Civil dawn; Sun is 6° below the horizon in the morning
@@ -211,6 +231,8 @@ func (z *Zmanim) GregEve() time.Time {
 	prev := time.Date(z.Year, z.Month, z.Day-1, 0, 0, 0, 0, z.TimeZone)
 	zman := New(z.Location, prev)
 	zman.UseElevation = z.UseElevation
+	zman.PolarFallback = z.PolarFallback
+	zman.PolarLatitude = z.PolarLatitude
 	return zman.Sunset()
 }
 
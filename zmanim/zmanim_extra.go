@@ -27,14 +27,14 @@ import (
 // elevation adjustment, regardless of the [Zmanim.UseElevation] setting.
 func (z *Zmanim) SeaLevelSunrise() time.Time {
 	t := z.calc.GetUTCSunrise(z.adjustedDate(), z.geo, noaa.GeometricZenith, false)
-	return z.inLoc(z.getInstantFromTime(t, eventSunrise))
+	return z.polar(z.inLoc(z.getInstantFromTime(t, eventSunrise)), 0, true, (*Zmanim).SeaLevelSunrise)
 }
 
 // SeaLevelSunset is sunset (0.833° below the horizon) calculated without any
 // elevation adjustment, regardless of the [Zmanim.UseElevation] setting.
 func (z *Zmanim) SeaLevelSunset() time.Time {
 	t := z.calc.GetUTCSunset(z.adjustedDate(), z.geo, noaa.GeometricZenith, false)
-	return z.inLoc(z.getInstantFromTime(t, eventSunset))
+	return z.polar(z.inLoc(z.getInstantFromTime(t, eventSunset)), 0, false, (*Zmanim).SeaLevelSunset)
 }
 
 // addTemporalHours returns start plus the given number of proportional (halachic)
@@ -0,0 +1,474 @@
+package zmanim
+
+// Hebcal - A Jewish Calendar Generator
+// Copyright (c) 2026 Michael J. Radwin
+// Additional zmanim ported from the KosherJava ComplexZmanimCalendar class.
+//
+// This program is free software; you can redistribute it and/or
+// modify it under the terms of the GNU General Public License
+// as published by the Free Software Foundation; either version 2
+// of the License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+import (
+	"time"
+)
+
+// offsetTime returns t plus d, or the zero time if t is the zero time (the
+// event does not occur, e.g. in polar regions).
+func offsetTime(t time.Time, d time.Duration) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.Add(d)
+}
+
+// zmanisOffset returns t plus the given number of halachic hours (negative
+// for an earlier time), where a halachic hour is 1/12 of the day from sunrise
+// to sunset. It returns the zero time if t, sunrise or sunset is the zero
+// time.
+func (z *Zmanim) zmanisOffset(t time.Time, hours float64) time.Time {
+	rise, set := z.Sunrise(), z.Sunset()
+	if t.IsZero() || rise.IsZero() || set.IsZero() {
+		return time.Time{}
+	}
+	temporalHour := float64(set.Unix()-rise.Unix()) / 12.0 // seconds per proportional hour
+	return time.Unix(t.Unix()+int64(temporalHour*hours), 0).In(z.TimeZone)
+}
+
+// addHalfDayHours returns start plus the given number of hours, where one
+// hour is (end - start) / 6. It is used for zmanim that divide only half of
+// the day, such as those measured from or to chatzot.
+func (z *Zmanim) addHalfDayHours(start, end time.Time, hours float64) time.Time {
+	return z.addTemporalHours(start, end, hours*2)
+}
+
+// ---------------------------------------------------------------------------
+// Alos and tzais by fixed and proportional minutes
+//
+// The "minutes" zmanim are a fixed number of minutes before sunrise or after
+// sunset. The "zmanis" zmanim are the same number of proportional minutes,
+// 1/720 of the day from sunrise to sunset, so that 72 minutes zmaniyos are
+// 1.2 halachic hours. If [Zmanim.UseElevation] is set, elevation is included
+// via sunrise and sunset.
+// ---------------------------------------------------------------------------
+
+// Alos72 is dawn calculated as 72 minutes before sunrise.
+func (z *Zmanim) Alos72() time.Time {
+	return offsetTime(z.Sunrise(), -72*time.Minute)
+}
+
+// Alos72Zmanis is dawn calculated as 72 minutes zmaniyos (1.2 halachic hours)
+// before sunrise.
+func (z *Zmanim) Alos72Zmanis() time.Time {
+	return z.zmanisOffset(z.Sunrise(), -1.2)
+}
+
+// Alos90 is dawn calculated as 90 minutes before sunrise.
+func (z *Zmanim) Alos90() time.Time {
+	return offsetTime(z.Sunrise(), -90*time.Minute)
+}
+
+// Alos90Zmanis is dawn calculated as 90 minutes zmaniyos (1.5 halachic hours)
+// before sunrise.
+func (z *Zmanim) Alos90Zmanis() time.Time {
+	return z.zmanisOffset(z.Sunrise(), -1.5)
+}
+
+// Alos96 is dawn calculated as 96 minutes before sunrise.
+func (z *Zmanim) Alos96() time.Time {
+	return offsetTime(z.Sunrise(), -96*time.Minute)
+}
+
+// Alos96Zmanis is dawn calculated as 96 minutes zmaniyos (1.6 halachic hours)
+// before sunrise.
+func (z *Zmanim) Alos96Zmanis() time.Time {
+	return z.zmanisOffset(z.Sunrise(), -1.6)
+}
+
+// Alos120 is dawn calculated as 120 minutes before sunrise.
+func (z *Zmanim) Alos120() time.Time {
+	return offsetTime(z.Sunrise(), -120*time.Minute)
+}
+
+// Alos120Zmanis is dawn calculated as 120 minutes zmaniyos (2 halachic hours)
+// before sunrise.
+func (z *Zmanim) Alos120Zmanis() time.Time {
+	return z.zmanisOffset(z.Sunrise(), -2)
+}
+
+// Tzais72 is nightfall according to Rabbeinu Tam, 72 minutes after sunset.
+func (z *Zmanim) Tzais72() time.Time {
+	return offsetTime(z.Sunset(), 72*time.Minute)
+}
+
+// Tzais72Zmanis is nightfall according to Rabbeinu Tam, 72 minutes zmaniyos
+// (1.2 halachic hours) after sunset.
+func (z *Zmanim) Tzais72Zmanis() time.Time {
+	return z.zmanisOffset(z.Sunset(), 1.2)
+}
+
+// Tzais90 is nightfall calculated as 90 minutes after sunset.
+func (z *Zmanim) Tzais90() time.Time {
+	return offsetTime(z.Sunset(), 90*time.Minute)
+}
+
+// Tzais90Zmanis is nightfall calculated as 90 minutes zmaniyos (1.5 halachic
+// hours) after sunset.
+func (z *Zmanim) Tzais90Zmanis() time.Time {
+	return z.zmanisOffset(z.Sunset(), 1.5)
+}
+
+// Tzais96 is nightfall calculated as 96 minutes after sunset.
+func (z *Zmanim) Tzais96() time.Time {
+	return offsetTime(z.Sunset(), 96*time.Minute)
+}
+
+// Tzais96Zmanis is nightfall calculated as 96 minutes zmaniyos (1.6 halachic
+// hours) after sunset.
+func (z *Zmanim) Tzais96Zmanis() time.Time {
+	return z.zmanisOffset(z.Sunset(), 1.6)
+}
+
+// Tzais120 is nightfall calculated as 120 minutes after sunset.
+func (z *Zmanim) Tzais120() time.Time {
+	return offsetTime(z.Sunset(), 120*time.Minute)
+}
+
+// Tzais120Zmanis is nightfall calculated as 120 minutes zmaniyos (2 halachic
+// hours) after sunset.
+func (z *Zmanim) Tzais120Zmanis() time.Time {
+	return z.zmanisOffset(z.Sunset(), 2)
+}
+
+// ---------------------------------------------------------------------------
+// Tzais of the Geonim
+//
+// Nightfall according to the Geonim, as the sun's depression below the
+// western horizon equivalent to a fixed time after sunset in Jerusalem
+// around the equinox. See also [Tzeit3MediumStars] and [Tzeit3SmallStars].
+// ---------------------------------------------------------------------------
+
+// TzaisGeonim3Point7Degrees is nightfall when the sun is 3.7° below the
+// horizon: 3/4 of an 18-minute mil, or 13.5 minutes, after sunset.
+func (z *Zmanim) TzaisGeonim3Point7Degrees() time.Time {
+	return z.TimeAtAngle(3.7, false)
+}
+
+// TzaisGeonim3Point8Degrees is nightfall when the sun is 3.8° below the
+// horizon: 3/4 of an 18-minute mil after sunset, rounded up to include
+// refraction.
+func (z *Zmanim) TzaisGeonim3Point8Degrees() time.Time {
+	return z.TimeAtAngle(3.8, false)
+}
+
+// TzaisGeonim5Point95Degrees is nightfall when the sun is 5.95° below the
+// horizon: 24 minutes after sunset.
+func (z *Zmanim) TzaisGeonim5Point95Degrees() time.Time {
+	return z.TimeAtAngle(5.95, false)
+}
+
+// TzaisGeonim6Point45Degrees is nightfall when the sun is 6.45° below the
+// horizon: 28 to 31 minutes after sunset, the opinion of Rabbi Yechiel
+// Michel Tucazinsky.
+func (z *Zmanim) TzaisGeonim6Point45Degrees() time.Time {
+	return z.TimeAtAngle(6.45, false)
+}
+
+// ---------------------------------------------------------------------------
+// Magen Avraham by fixed and proportional minutes
+//
+// The MGA day runs from one of the alos above to the matching tzais. The
+// 72-minute day is [Zmanim.SofZmanShmaMGA] and [Zmanim.SofZmanTfillaMGA],
+// which are measured from sea level.
+// ---------------------------------------------------------------------------
+
+// SofZmanShmaMGA72MinutesZmanis is the latest Shema (MGA), 3 halachic hours
+// after dawn, where the day is from [Zmanim.Alos72Zmanis] to
+// [Zmanim.Tzais72Zmanis].
+func (z *Zmanim) SofZmanShmaMGA72MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos72Zmanis(), z.Tzais72Zmanis(), 3)
+}
+
+// SofZmanShmaMGA90Minutes is the latest Shema (MGA), 3 halachic hours after
+// dawn, where the day is from [Zmanim.Alos90] to [Zmanim.Tzais90].
+func (z *Zmanim) SofZmanShmaMGA90Minutes() time.Time {
+	return z.addTemporalHours(z.Alos90(), z.Tzais90(), 3)
+}
+
+// SofZmanShmaMGA90MinutesZmanis is the latest Shema (MGA), 3 halachic hours
+// after dawn, where the day is from [Zmanim.Alos90Zmanis] to
+// [Zmanim.Tzais90Zmanis].
+func (z *Zmanim) SofZmanShmaMGA90MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos90Zmanis(), z.Tzais90Zmanis(), 3)
+}
+
+// SofZmanShmaMGA96Minutes is the latest Shema (MGA), 3 halachic hours after
+// dawn, where the day is from [Zmanim.Alos96] to [Zmanim.Tzais96].
+func (z *Zmanim) SofZmanShmaMGA96Minutes() time.Time {
+	return z.addTemporalHours(z.Alos96(), z.Tzais96(), 3)
+}
+
+// SofZmanShmaMGA96MinutesZmanis is the latest Shema (MGA), 3 halachic hours
+// after dawn, where the day is from [Zmanim.Alos96Zmanis] to
+// [Zmanim.Tzais96Zmanis].
+func (z *Zmanim) SofZmanShmaMGA96MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos96Zmanis(), z.Tzais96Zmanis(), 3)
+}
+
+// SofZmanShmaMGA120Minutes is the latest Shema (MGA), 3 halachic hours after
+// dawn, where the day is from [Zmanim.Alos120] to [Zmanim.Tzais120].
+func (z *Zmanim) SofZmanShmaMGA120Minutes() time.Time {
+	return z.addTemporalHours(z.Alos120(), z.Tzais120(), 3)
+}
+
+// SofZmanTfillaMGA72MinutesZmanis is the latest Shacharit (MGA), 4 halachic
+// hours after dawn, where the day is from [Zmanim.Alos72Zmanis] to
+// [Zmanim.Tzais72Zmanis].
+func (z *Zmanim) SofZmanTfillaMGA72MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos72Zmanis(), z.Tzais72Zmanis(), 4)
+}
+
+// SofZmanTfillaMGA90Minutes is the latest Shacharit (MGA), 4 halachic hours
+// after dawn, where the day is from [Zmanim.Alos90] to [Zmanim.Tzais90].
+func (z *Zmanim) SofZmanTfillaMGA90Minutes() time.Time {
+	return z.addTemporalHours(z.Alos90(), z.Tzais90(), 4)
+}
+
+// SofZmanTfillaMGA90MinutesZmanis is the latest Shacharit (MGA), 4 halachic
+// hours after dawn, where the day is from [Zmanim.Alos90Zmanis] to
+// [Zmanim.Tzais90Zmanis].
+func (z *Zmanim) SofZmanTfillaMGA90MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos90Zmanis(), z.Tzais90Zmanis(), 4)
+}
+
+// SofZmanTfillaMGA96Minutes is the latest Shacharit (MGA), 4 halachic hours
+// after dawn, where the day is from [Zmanim.Alos96] to [Zmanim.Tzais96].
+func (z *Zmanim) SofZmanTfillaMGA96Minutes() time.Time {
+	return z.addTemporalHours(z.Alos96(), z.Tzais96(), 4)
+}
+
+// SofZmanTfillaMGA96MinutesZmanis is the latest Shacharit (MGA), 4 halachic
+// hours after dawn, where the day is from [Zmanim.Alos96Zmanis] to
+// [Zmanim.Tzais96Zmanis].
+func (z *Zmanim) SofZmanTfillaMGA96MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos96Zmanis(), z.Tzais96Zmanis(), 4)
+}
+
+// SofZmanTfillaMGA120Minutes is the latest Shacharit (MGA), 4 halachic hours
+// after dawn, where the day is from [Zmanim.Alos120] to [Zmanim.Tzais120].
+func (z *Zmanim) SofZmanTfillaMGA120Minutes() time.Time {
+	return z.addTemporalHours(z.Alos120(), z.Tzais120(), 4)
+}
+
+// PlagHaMincha72Minutes is Plag HaMincha (MGA), 10.75 halachic hours after
+// dawn, where the day is from [Zmanim.Alos72] to [Zmanim.Tzais72].
+func (z *Zmanim) PlagHaMincha72Minutes() time.Time {
+	return z.addTemporalHours(z.Alos72(), z.Tzais72(), 10.75)
+}
+
+// PlagHaMincha72MinutesZmanis is Plag HaMincha (MGA), 10.75 halachic hours
+// after dawn, where the day is from [Zmanim.Alos72Zmanis] to
+// [Zmanim.Tzais72Zmanis].
+func (z *Zmanim) PlagHaMincha72MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos72Zmanis(), z.Tzais72Zmanis(), 10.75)
+}
+
+// PlagHaMincha90Minutes is Plag HaMincha (MGA), 10.75 halachic hours after
+// dawn, where the day is from [Zmanim.Alos90] to [Zmanim.Tzais90].
+func (z *Zmanim) PlagHaMincha90Minutes() time.Time {
+	return z.addTemporalHours(z.Alos90(), z.Tzais90(), 10.75)
+}
+
+// PlagHaMincha90MinutesZmanis is Plag HaMincha (MGA), 10.75 halachic hours
+// after dawn, where the day is from [Zmanim.Alos90Zmanis] to
+// [Zmanim.Tzais90Zmanis].
+func (z *Zmanim) PlagHaMincha90MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos90Zmanis(), z.Tzais90Zmanis(), 10.75)
+}
+
+// PlagHaMincha96Minutes is Plag HaMincha (MGA), 10.75 halachic hours after
+// dawn, where the day is from [Zmanim.Alos96] to [Zmanim.Tzais96].
+func (z *Zmanim) PlagHaMincha96Minutes() time.Time {
+	return z.addTemporalHours(z.Alos96(), z.Tzais96(), 10.75)
+}
+
+// PlagHaMincha96MinutesZmanis is Plag HaMincha (MGA), 10.75 halachic hours
+// after dawn, where the day is from [Zmanim.Alos96Zmanis] to
+// [Zmanim.Tzais96Zmanis].
+func (z *Zmanim) PlagHaMincha96MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos96Zmanis(), z.Tzais96Zmanis(), 10.75)
+}
+
+// PlagHaMincha120Minutes is Plag HaMincha (MGA), 10.75 halachic hours after
+// dawn, where the day is from [Zmanim.Alos120] to [Zmanim.Tzais120].
+func (z *Zmanim) PlagHaMincha120Minutes() time.Time {
+	return z.addTemporalHours(z.Alos120(), z.Tzais120(), 10.75)
+}
+
+// PlagHaMincha120MinutesZmanis is Plag HaMincha (MGA), 10.75 halachic hours
+// after dawn, where the day is from [Zmanim.Alos120Zmanis] to
+// [Zmanim.Tzais120Zmanis].
+func (z *Zmanim) PlagHaMincha120MinutesZmanis() time.Time {
+	return z.addTemporalHours(z.Alos120Zmanis(), z.Tzais120Zmanis(), 10.75)
+}
+
+// ---------------------------------------------------------------------------
+// Ateret Torah zmanim
+//
+// Rabbi Yaakov Moshe Hillel's Ateret Torah calculates the day from
+// [Zmanim.Alos72Zmanis] to tzais 40 minutes after sunset.
+// ---------------------------------------------------------------------------
+
+// ateretTorahSunsetOffset is the number of minutes after sunset of tzais
+// according to the Ateret Torah.
+const ateretTorahSunsetOffset = 40 * time.Minute
+
+// shaahZmanisAteretTorah returns the given number of proportional hours after
+// [Zmanim.Alos72Zmanis], where the day ends at [Zmanim.TzaisAteretTorah].
+func (z *Zmanim) shaahZmanisAteretTorah(hours float64) time.Time {
+	return z.addTemporalHours(z.Alos72Zmanis(), z.TzaisAteretTorah(), hours)
+}
+
+// TzaisAteretTorah is nightfall according to the Ateret Torah, 40 minutes
+// after sunset.
+func (z *Zmanim) TzaisAteretTorah() time.Time {
+	return offsetTime(z.Sunset(), ateretTorahSunsetOffset)
+}
+
+// SofZmanShmaAteretTorah is the latest Shema according to the Ateret Torah:
+// 3 proportional hours after [Zmanim.Alos72Zmanis].
+func (z *Zmanim) SofZmanShmaAteretTorah() time.Time {
+	return z.shaahZmanisAteretTorah(3)
+}
+
+// SofZmanTfillaAteretTorah is the latest Shacharit according to the Ateret
+// Torah: 4 proportional hours after [Zmanim.Alos72Zmanis].
+func (z *Zmanim) SofZmanTfillaAteretTorah() time.Time {
+	return z.shaahZmanisAteretTorah(4)
+}
+
+// MinchaGedolaAteretTorah is the earliest Mincha according to the Ateret
+// Torah: 6.5 proportional hours after [Zmanim.Alos72Zmanis].
+func (z *Zmanim) MinchaGedolaAteretTorah() time.Time {
+	return z.shaahZmanisAteretTorah(6.5)
+}
+
+// MinchaKetanaAteretTorah is the preferable earliest Mincha according to the
+// Ateret Torah: 9.5 proportional hours after [Zmanim.Alos72Zmanis].
+func (z *Zmanim) MinchaKetanaAteretTorah() time.Time {
+	return z.shaahZmanisAteretTorah(9.5)
+}
+
+// PlagHaMinchaAteretTorah is Plag HaMincha according to the Ateret Torah:
+// 10.75 proportional hours after [Zmanim.Alos72Zmanis].
+func (z *Zmanim) PlagHaMinchaAteretTorah() time.Time {
+	return z.shaahZmanisAteretTorah(10.75)
+}
+
+// ---------------------------------------------------------------------------
+// Bein hashmashos of the Yereim
+//
+// The Yereim holds that bein hashmashos begins 3/4 of a mil before sunset.
+// Depending on the length of a mil (18, 22.5 or 24 minutes), this is 13.5,
+// 16.875 or 18 minutes before sunset, or the equivalent elevation of the sun
+// above the horizon in Jerusalem around the equinox.
+// ---------------------------------------------------------------------------
+
+// BeinHashmashosYereim18Minutes is the start of bein hashmashos according to
+// the Yereim, 18 minutes before sunset.
+func (z *Zmanim) BeinHashmashosYereim18Minutes() time.Time {
+	return offsetTime(z.Sunset(), -18*time.Minute)
+}
+
+// BeinHashmashosYereim16Point875Minutes is the start of bein hashmashos
+// according to the Yereim, 16.875 minutes before sunset.
+func (z *Zmanim) BeinHashmashosYereim16Point875Minutes() time.Time {
+	return offsetTime(z.Sunset(), -time.Duration(16.875*float64(time.Minute)))
+}
+
+// BeinHashmashosYereim13Point5Minutes is the start of bein hashmashos
+// according to the Yereim, 13.5 minutes before sunset.
+func (z *Zmanim) BeinHashmashosYereim13Point5Minutes() time.Time {
+	return offsetTime(z.Sunset(), ThirteenFive)
+}
+
+// BeinHashmashosYereim3Point05Degrees is the start of bein hashmashos
+// according to the Yereim, when the sun is 3.05° above the horizon (18
+// minutes before sunset).
+func (z *Zmanim) BeinHashmashosYereim3Point05Degrees() time.Time {
+	return z.TimeAtAngle(-3.05, false)
+}
+
+// BeinHashmashosYereim2Point8Degrees is the start of bein hashmashos
+// according to the Yereim, when the sun is 2.8° above the horizon (16.875
+// minutes before sunset).
+func (z *Zmanim) BeinHashmashosYereim2Point8Degrees() time.Time {
+	return z.TimeAtAngle(-2.8, false)
+}
+
+// BeinHashmashosYereim2Point1Degrees is the start of bein hashmashos
+// according to the Yereim, when the sun is 2.1° above the horizon (13.5
+// minutes before sunset).
+func (z *Zmanim) BeinHashmashosYereim2Point1Degrees() time.Time {
+	return z.TimeAtAngle(-2.1, false)
+}
+
+// ---------------------------------------------------------------------------
+// Zmanim based on fixed local chatzot
+//
+// Some opinions divide the morning and afternoon separately, from sunrise to
+// chatzot and from chatzot to sunset, with chatzot fixed at 12:00 local mean
+// time rather than halfway between sunrise and sunset. A halachic hour is
+// then 1/6 of each half of the day.
+// ---------------------------------------------------------------------------
+
+// FixedLocalChatzot is 12:00 local mean time: noon according to the
+// longitude of the location, ignoring the equation of time and the time
+// zone.
+func (z *Zmanim) FixedLocalChatzot() time.Time {
+	noon := time.Date(z.Year, z.Month, z.Day, 12, 0, 0, 0, time.UTC)
+	offset := time.Duration(z.Location.Longitude / 15 * float64(time.Hour))
+	return noon.Add(-offset).Round(time.Second).In(z.TimeZone)
+}
+
+// SofZmanShmaGRASunriseToFixedLocalChatzot is the latest Shema (Gra), 3
+// halachic hours after sunrise, where the morning is from sunrise to
+// [Zmanim.FixedLocalChatzot].
+func (z *Zmanim) SofZmanShmaGRASunriseToFixedLocalChatzot() time.Time {
+	return z.addHalfDayHours(z.Sunrise(), z.FixedLocalChatzot(), 3)
+}
+
+// SofZmanTfillaGRASunriseToFixedLocalChatzot is the latest Shacharit (Gra), 4
+// halachic hours after sunrise, where the morning is from sunrise to
+// [Zmanim.FixedLocalChatzot].
+func (z *Zmanim) SofZmanTfillaGRASunriseToFixedLocalChatzot() time.Time {
+	return z.addHalfDayHours(z.Sunrise(), z.FixedLocalChatzot(), 4)
+}
+
+// MinchaGedolaGRAFixedLocalChatzot30Minutes is the earliest Mincha, 30
+// minutes after [Zmanim.FixedLocalChatzot].
+func (z *Zmanim) MinchaGedolaGRAFixedLocalChatzot30Minutes() time.Time {
+	return z.FixedLocalChatzot().Add(30 * time.Minute)
+}
+
+// MinchaKetanaGRAFixedLocalChatzotToSunset is the preferable earliest Mincha,
+// 3.5 halachic hours after [Zmanim.FixedLocalChatzot], where the afternoon is
+// from chatzot to sunset.
+func (z *Zmanim) MinchaKetanaGRAFixedLocalChatzotToSunset() time.Time {
+	return z.addHalfDayHours(z.FixedLocalChatzot(), z.Sunset(), 3.5)
+}
+
+// PlagHaMinchaGRAFixedLocalChatzotToSunset is Plag HaMincha, 4.75 halachic
+// hours after [Zmanim.FixedLocalChatzot], where the afternoon is from chatzot
+// to sunset.
+func (z *Zmanim) PlagHaMinchaGRAFixedLocalChatzotToSunset() time.Time {
+	return z.addHalfDayHours(z.FixedLocalChatzot(), z.Sunset(), 4.75)
+}
@@ -0,0 +1,128 @@
+package zmanim_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hebcal/hebcal-go/zmanim"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpinionZmanimFixedMinutes(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	sunrise, sunset := z.Sunrise(), z.Sunset()
+	assert.Equal(sunrise.Add(-72*time.Minute), z.Alos72())
+	assert.Equal(sunrise.Add(-90*time.Minute), z.Alos90())
+	assert.Equal(sunrise.Add(-96*time.Minute), z.Alos96())
+	assert.Equal(sunrise.Add(-120*time.Minute), z.Alos120())
+	assert.Equal(sunset.Add(72*time.Minute), z.Tzais72())
+	assert.Equal(sunset.Add(90*time.Minute), z.Tzais90())
+	assert.Equal(sunset.Add(96*time.Minute), z.Tzais96())
+	assert.Equal(sunset.Add(120*time.Minute), z.Tzais120())
+	assert.Equal(sunset.Add(40*time.Minute), z.TzaisAteretTorah())
+	assert.Equal(sunset.Add(-18*time.Minute), z.BeinHashmashosYereim18Minutes())
+	assert.Equal(sunset.Add(-16*time.Minute-52500*time.Millisecond), z.BeinHashmashosYereim16Point875Minutes())
+	assert.Equal(sunset.Add(-13*time.Minute-30*time.Second), z.BeinHashmashosYereim13Point5Minutes())
+}
+
+func TestOpinionZmanimZmanis(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	sunrise, sunset := z.Sunrise(), z.Sunset()
+	hour := z.Hour() // a spring day in Jerusalem is longer than 12 hours
+	assert.Greater(hour, 3600.0)
+	offset := func(t time.Time, hours float64) time.Time {
+		return time.Unix(t.Unix()+int64(hour*hours), 0).In(t.Location())
+	}
+	assert.Equal(offset(sunrise, -1.2), z.Alos72Zmanis())
+	assert.Equal(offset(sunrise, -1.5), z.Alos90Zmanis())
+	assert.Equal(offset(sunrise, -1.6), z.Alos96Zmanis())
+	assert.Equal(offset(sunrise, -2), z.Alos120Zmanis())
+	assert.Equal(offset(sunset, 1.2), z.Tzais72Zmanis())
+	assert.Equal(offset(sunset, 2), z.Tzais120Zmanis())
+	assert.True(z.Alos72Zmanis().Before(z.Alos72()))
+	assert.True(z.Tzais72Zmanis().After(z.Tzais72()))
+
+	// A longer MGA day moves sof zman shma earlier and plag later
+	assert.True(z.SofZmanShmaMGA90Minutes().Before(z.SofZmanShmaMGA()))
+	assert.True(z.SofZmanShmaMGA96Minutes().Before(z.SofZmanShmaMGA90Minutes()))
+	assert.True(z.SofZmanShmaMGA120Minutes().Before(z.SofZmanShmaMGA96Minutes()))
+	assert.True(z.SofZmanShmaMGA72MinutesZmanis().Before(z.SofZmanShmaMGA()))
+	assert.True(z.SofZmanTfillaMGA120Minutes().Before(z.SofZmanTfillaMGA90Minutes()))
+	assert.True(z.SofZmanShmaMGA90Minutes().Before(z.SofZmanTfillaMGA90Minutes()))
+	assert.True(z.PlagHaMincha72Minutes().After(z.PlagHaMincha()))
+	assert.True(z.PlagHaMincha120Minutes().After(z.PlagHaMincha90Minutes()))
+	assert.True(z.PlagHaMincha120MinutesZmanis().After(z.PlagHaMincha120Minutes()))
+
+	// Ateret Torah
+	assert.True(z.SofZmanShmaAteretTorah().Before(z.SofZmanShmaMGA72MinutesZmanis()))
+	assert.True(z.SofZmanShmaAteretTorah().Before(z.SofZmanTfillaAteretTorah()))
+	assert.True(z.MinchaGedolaAteretTorah().Before(z.MinchaKetanaAteretTorah()))
+	assert.True(z.MinchaKetanaAteretTorah().Before(z.PlagHaMinchaAteretTorah()))
+	assert.True(z.PlagHaMinchaAteretTorah().Before(sunset))
+}
+
+func TestOpinionZmanimDegrees(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	sunset := z.Sunset()
+	geonim := []time.Time{
+		z.TzaisGeonim3Point7Degrees(),
+		z.TzaisGeonim3Point8Degrees(),
+		z.TzaisGeonim5Point95Degrees(),
+		z.TzaisGeonim6Point45Degrees(),
+		z.Tzeit(zmanim.Tzeit3MediumStars),
+	}
+	prev := sunset
+	for _, tzais := range geonim {
+		assert.True(tzais.After(prev), "%v after %v", tzais, prev)
+		prev = tzais
+	}
+	// 3.7° is about 13.5 minutes after sunset in Jerusalem
+	assert.InDelta(13.5, geonim[0].Sub(sunset).Minutes(), 2)
+	yereim := []time.Time{
+		z.BeinHashmashosYereim3Point05Degrees(),
+		z.BeinHashmashosYereim2Point8Degrees(),
+		z.BeinHashmashosYereim2Point1Degrees(),
+		sunset,
+	}
+	for i := 1; i < len(yereim); i++ {
+		assert.True(yereim[i].After(yereim[i-1]), "%v after %v", yereim[i], yereim[i-1])
+	}
+	assert.InDelta(18, sunset.Sub(yereim[0]).Minutes(), 2)
+}
+
+func TestFixedLocalChatzot(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Jerusalem", "IL", 31.76904, 35.21633, 786, "Asia/Jerusalem")
+	z := zmanim.New(&loc, time.Date(2024, time.April, 26, 12, 0, 0, 0, time.UTC))
+	chatzot := z.FixedLocalChatzot()
+	assert.Equal("2024-04-26T12:39:08+03:00", chatzot.Format(time.RFC3339))
+	assert.Equal(chatzot.Add(30*time.Minute), z.MinchaGedolaGRAFixedLocalChatzot30Minutes())
+	sunrise, sunset := z.Sunrise(), z.Sunset()
+	half := sunset.Sub(chatzot) / 6
+	assert.InDelta(0, z.PlagHaMinchaGRAFixedLocalChatzotToSunset().Sub(chatzot.Add(half*19/4)).Seconds(), 1)
+	assert.InDelta(0, z.MinchaKetanaGRAFixedLocalChatzotToSunset().Sub(chatzot.Add(half*7/2)).Seconds(), 1)
+	morning := chatzot.Sub(sunrise) / 6
+	assert.InDelta(0, z.SofZmanShmaGRASunriseToFixedLocalChatzot().Sub(sunrise.Add(morning*3)).Seconds(), 1)
+	assert.InDelta(0, z.SofZmanTfillaGRASunriseToFixedLocalChatzot().Sub(sunrise.Add(morning*4)).Seconds(), 1)
+}
+
+func TestOpinionZmanimPolar(t *testing.T) {
+	assert := assert.New(t)
+	loc := zmanim.NewLocation("Tromso", "NO", 69.6489, 18.9551, 0, "Europe/Oslo")
+	z := zmanim.New(&loc, time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC))
+	assert.True(z.Alos72().IsZero())
+	assert.True(z.Alos72Zmanis().IsZero())
+	assert.True(z.Tzais72().IsZero())
+	assert.True(z.TzaisGeonim3Point7Degrees().IsZero())
+	assert.True(z.SofZmanShmaMGA90Minutes().IsZero())
+	assert.True(z.PlagHaMinchaAteretTorah().IsZero())
+	assert.True(z.BeinHashmashosYereim18Minutes().IsZero())
+	assert.True(z.PlagHaMinchaGRAFixedLocalChatzotToSunset().IsZero())
+	assert.False(z.FixedLocalChatzot().IsZero())
+}